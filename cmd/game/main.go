@@ -42,15 +42,18 @@ func main() {
 
 	slog.Info("Initializing Game")
 
-	gameWorld := world.NewWorld(worldWidth, worldHeight, groundLevel, worldSeed)
+	worldConfig := world.NewConfig(worldWidth, worldHeight, groundLevel)
+	worldConfig.SurfaceAmplitude = world.DefaultSurfaceAmplitude
+	gameWorld := world.NewWorldFromConfig(worldConfig, worldSeed)
 	game := engine.NewGame(gameWorld)
 
 	for renderer.WindowShouldClose() == false {
-		dt := renderer.GetFrameTime() // Delta time in seconds
+		frameTime := renderer.GetFrameTime() // Seconds since the last frame
 
 		inputState := inputAdapter.ReadInput()
 
-		err := game.Update(dt, inputState)
+		// The simulation runs in fixed ticks; rendering interpolates between them
+		err := game.Advance(frameTime, inputState)
 		if err != nil {
 			slog.Error("Error during update", "error", err)
 			break
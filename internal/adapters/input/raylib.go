@@ -20,10 +20,41 @@ func (a *RaylibInputAdapter) ReadInput() input.InputState {
 		Up:          rl.IsKeyDown(rl.KeyUp) || rl.IsKeyDown(rl.KeyW),
 		Drill:       rl.IsKeyDown(rl.KeyDown) || rl.IsKeyDown(rl.KeyS),
 		Sell:        rl.IsKeyPressed(rl.KeyE),
-		UseTeleport: rl.IsKeyPressed(rl.KeyT),
-		UseRepair:   rl.IsKeyPressed(rl.KeyR),
-		UseRefuel:   rl.IsKeyPressed(rl.KeyF),
-		UseBomb:     rl.IsKeyPressed(rl.KeyB),
-		UseBigBomb:  rl.IsKeyPressed(rl.KeyG),
+		ToggleMap:   rl.IsKeyPressed(rl.KeyM),
+		Scan:        rl.IsKeyPressed(rl.KeyQ),
+		CancelDrill: rl.IsKeyPressed(rl.KeyX),
+		SellBack:    rl.IsKeyPressed(rl.KeyR),
+
+		CycleCargoPolicy: rl.IsKeyPressed(rl.KeyC),
+		KeepNewOre:       rl.IsKeyPressed(rl.KeyY),
+		DiscardNewOre:    rl.IsKeyPressed(rl.KeyN),
+		JettisonOre:      readJettisonKey(),
+
+		CycleItem:       readCycleItemKey(),
+		UseSelectedItem: rl.IsKeyPressed(rl.KeySpace),
+	}
+}
+
+// readCycleItemKey returns -1 for [ and +1 for ] to move the hotbar selection, or 0
+func readCycleItemKey() int {
+	if rl.IsKeyPressed(rl.KeyLeftBracket) {
+		return -1
+	}
+	if rl.IsKeyPressed(rl.KeyRightBracket) {
+		return 1
+	}
+	return 0
+}
+
+// jettisonKeys maps number keys 1-6 to ore types in OreType order
+var jettisonKeys = [6]int32{rl.KeyOne, rl.KeyTwo, rl.KeyThree, rl.KeyFour, rl.KeyFive, rl.KeySix}
+
+// readJettisonKey returns OreType+1 for the first pressed number key, or 0
+func readJettisonKey() int {
+	for i, key := range jettisonKeys {
+		if rl.IsKeyPressed(key) {
+			return i + 1
+		}
 	}
+	return 0
 }
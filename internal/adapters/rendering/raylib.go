@@ -2,6 +2,7 @@ package rendering
 
 import (
 	"fmt"
+	"math"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 
@@ -9,30 +10,59 @@ import (
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
 	"github.com/Kishlin/drill-game/internal/domain/physics"
+	"github.com/Kishlin/drill-game/internal/domain/systems"
 	"github.com/Kishlin/drill-game/internal/domain/types"
 	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
 var (
-	PlayerColor       = rl.Red
-	GroundColor       = rl.Brown
-	SkyColor          = rl.SkyBlue
-	DirtColor         = rl.NewColor(139, 90, 43, 255)   // Brown dirt
-	GridColor         = rl.NewColor(100, 65, 30, 128)   // Semi-transparent grid lines
-	MarketColor       = rl.NewColor(34, 139, 34, 255)   // Forest Green
-	FuelStationColor  = rl.NewColor(255, 165, 0, 255)   // Orange
-	HospitalColor     = rl.NewColor(220, 20, 60, 255)   // Crimson
-	EngineShopColor    = rl.NewColor(70, 130, 180, 255)  // Steel Blue
-	HullShopColor      = rl.NewColor(105, 105, 105, 255) // Dim Gray
-	FuelTankShopColor  = rl.NewColor(255, 99, 71, 255)   // Tomato
-	CargoHoldShopColor = rl.NewColor(148, 0, 211, 255)   // Dark Violet
-	HeatShieldShopColor = rl.NewColor(255, 69, 0, 255)   // Orange Red
-	DrillShopColor      = rl.NewColor(184, 134, 11, 255) // Dark Goldenrod
-	TeleportShopColor   = rl.NewColor(138, 43, 226, 255) // Blue Violet
-	RepairShopColor     = rl.NewColor(34, 139, 34, 255)  // Forest Green
-	RefuelShopColor     = rl.NewColor(255, 165, 0, 255)  // Orange
-	BombShopColor       = rl.NewColor(255, 20, 147, 255) // Deep Pink
-	BigBombShopColor    = rl.NewColor(220, 20, 60, 255)  // Crimson
+	PlayerColor          = rl.Red
+	GroundColor          = rl.Brown
+	SkyColor             = rl.SkyBlue
+	DirtColor            = rl.NewColor(139, 90, 43, 255)   // Brown dirt
+	GridColor            = rl.NewColor(100, 65, 30, 128)   // Semi-transparent grid lines
+	MarketColor          = rl.NewColor(34, 139, 34, 255)   // Forest Green
+	FuelStationColor     = rl.NewColor(255, 165, 0, 255)   // Orange
+	HospitalColor        = rl.NewColor(220, 20, 60, 255)   // Crimson
+	RepairShopColor      = rl.NewColor(169, 169, 169, 255) // Dark Gray
+	EngineShopColor      = rl.NewColor(70, 130, 180, 255)  // Steel Blue
+	HullShopColor        = rl.NewColor(105, 105, 105, 255) // Dim Gray
+	FuelTankShopColor    = rl.NewColor(255, 99, 71, 255)   // Tomato
+	CargoHoldShopColor   = rl.NewColor(148, 0, 211, 255)   // Dark Violet
+	HeatShieldShopColor  = rl.NewColor(255, 69, 0, 255)    // Orange Red
+	DrillShopColor       = rl.NewColor(184, 134, 11, 255)  // Dark Goldenrod
+	OreDetectorShopColor = rl.NewColor(0, 128, 128, 255)   // Teal
+	DarknessColor        = rl.NewColor(12, 10, 8, 255)     // Unexplored terrain
+	CaveColor            = rl.NewColor(60, 40, 20, 255)    // Explored empty space on the map
+	CrackColor           = rl.NewColor(30, 20, 10, 200)    // Damage lines on partially drilled tiles
+	BombColor            = rl.NewColor(40, 40, 40, 255)    // Placed bombs
+
+	// Upgrade shop fill and border colors by component slot; unlisted slots use DefaultUpgradeShopColors
+	UpgradeShopColors = map[entities.ComponentSlot][2]rl.Color{
+		entities.SlotEngine:      {EngineShopColor, rl.DarkBlue},
+		entities.SlotHull:        {HullShopColor, rl.DarkGray},
+		entities.SlotFuelTank:    {FuelTankShopColor, rl.Maroon},
+		entities.SlotCargoHold:   {CargoHoldShopColor, rl.NewColor(75, 0, 130, 255)},
+		entities.SlotHeatShield:  {HeatShieldShopColor, rl.Red},
+		entities.SlotDrill:       {DrillShopColor, rl.NewColor(139, 101, 8, 255)},
+		entities.SlotOreDetector: {OreDetectorShopColor, rl.DarkGreen},
+	}
+	DefaultUpgradeShopColors = [2]rl.Color{rl.NewColor(112, 128, 144, 255), rl.DarkGray} // Slate Gray
+
+	// Item shop fill and border colors by item; unlisted items use DefaultItemShopColors
+	ItemShopColors = map[entities.ItemID][2]rl.Color{
+		entities.ItemTeleport:   {rl.NewColor(138, 43, 226, 255), rl.Purple},   // Blue Violet
+		entities.ItemRepair:     {rl.NewColor(34, 139, 34, 255), rl.DarkGreen}, // Forest Green
+		entities.ItemRefuel:     {rl.NewColor(255, 165, 0, 255), rl.Orange},    // Orange
+		entities.ItemBomb:       {rl.NewColor(255, 20, 147, 255), rl.Maroon},   // Deep Pink
+		entities.ItemBigBomb:    {rl.NewColor(220, 20, 60, 255), rl.Red},       // Crimson
+		entities.ItemParachute:  {rl.NewColor(135, 206, 250, 255), rl.Blue},    // Light Sky Blue
+		entities.ItemShield:     {rl.NewColor(65, 105, 225, 255), rl.DarkBlue}, // Royal Blue
+		entities.ItemDrillBoost: {rl.NewColor(218, 165, 32, 255), rl.Brown},    // Goldenrod
+		entities.ItemCoolant:    {rl.NewColor(0, 206, 209, 255), rl.DarkBlue},  // Dark Turquoise
+		entities.ItemFlare:      {rl.NewColor(255, 255, 102, 255), rl.Orange},  // Pale Yellow
+	}
+	DefaultItemShopColors = [2]rl.Color{rl.NewColor(112, 128, 144, 255), rl.DarkGray} // Slate Gray
 
 	// Ore colors for different ore types
 	OreColors = map[entities.OreType]rl.Color{
@@ -50,6 +80,7 @@ type RaylibRenderer struct {
 	screenWidth  float32
 	screenHeight float32
 	worldWidth   float32 // Cached for boundary clamping
+	showMap      bool    // Map screen toggled with M
 }
 
 func NewRaylibRenderer(screenWidth, screenHeight int32) *RaylibRenderer {
@@ -66,16 +97,16 @@ func NewRaylibRenderer(screenWidth, screenHeight int32) *RaylibRenderer {
 	}
 }
 
-// updateCamera sets camera target to player position with boundary clamping
-func (r *RaylibRenderer) updateCamera(player *entities.Player, w *world.World) {
+// updateCamera sets camera target to the (interpolated) player position with boundary clamping
+func (r *RaylibRenderer) updateCamera(playerAABB types.AABB, w *world.World) {
 	// Cache world width on first call
 	if r.worldWidth == 0 {
 		r.worldWidth = w.Width
 	}
 
 	// Camera targets player center (AABB is top-left corner)
-	playerCenterX := player.AABB.X + player.AABB.Width/2
-	playerCenterY := player.AABB.Y + player.AABB.Height/2
+	playerCenterX := playerAABB.X + playerAABB.Width/2
+	playerCenterY := playerAABB.Y + playerAABB.Height/2
 
 	// Clamp camera to prevent viewing outside world bounds
 	halfScreenW := r.screenWidth / 2
@@ -83,7 +114,7 @@ func (r *RaylibRenderer) updateCamera(player *entities.Player, w *world.World) {
 
 	minX := halfScreenW
 	maxX := r.worldWidth - halfScreenW
-	minY := w.GetGroundLevel() - halfScreenH // Can't view above sky
+	minY := w.GetConfig().HighestSurfaceY() - halfScreenH // Can't view above the tallest hill
 
 	// Horizontal clamping
 	targetX := playerCenterX
@@ -104,8 +135,14 @@ func (r *RaylibRenderer) updateCamera(player *entities.Player, w *world.World) {
 }
 
 func (r *RaylibRenderer) Render(game *engine.Game, inputState input.InputState) {
+	if inputState.ToggleMap {
+		r.showMap = !r.showMap
+	}
+
 	// Update camera position before rendering
-	r.updateCamera(game.GetPlayer(), game.GetWorld())
+	// The player is drawn between its last two fixed-tick positions, so motion stays smooth at any frame rate
+	playerAABB := game.GetInterpolatedPlayerAABB()
+	r.updateCamera(playerAABB, game.GetWorld())
 
 	rl.BeginDrawing()
 	rl.ClearBackground(rl.RayWhite)
@@ -115,37 +152,42 @@ func (r *RaylibRenderer) Render(game *engine.Game, inputState input.InputState)
 
 	r.renderWorld(game.GetWorld())
 	r.renderTiles(game.GetWorld())
+	r.renderFogOfWar(game.GetWorld())
+	r.renderOreDetections(game.GetOreDetections())
 	r.renderMarket(game.GetMarket())
 	r.renderFuelStation(game.GetFuelStation())
 	r.renderHospital(game.GetHospital())
-	r.renderUpgradeShop(game.GetEngineShop().AABB, EngineShopColor, rl.DarkBlue)
-	r.renderUpgradeShop(game.GetHullShop().AABB, HullShopColor, rl.DarkGray)
-	r.renderUpgradeShop(game.GetFuelTankShop().AABB, FuelTankShopColor, rl.Maroon)
-	r.renderUpgradeShop(game.GetCargoHoldShop().AABB, CargoHoldShopColor, rl.NewColor(75, 0, 130, 255))
-	r.renderUpgradeShop(game.GetHeatShieldShop().AABB, HeatShieldShopColor, rl.Red)
-	r.renderUpgradeShop(game.GetDrillShop().AABB, DrillShopColor, rl.NewColor(139, 101, 8, 255))
+	r.renderUpgradeShop(game.GetRepairShop().AABB, RepairShopColor, rl.Yellow)
+	for _, shop := range game.GetUpgradeShops() {
+		colors, ok := UpgradeShopColors[shop.Slot]
+		if !ok {
+			colors = DefaultUpgradeShopColors
+		}
+		r.renderUpgradeShop(shop.AABB, colors[0], colors[1])
+		r.renderUpgradeQuote(game, shop)
+	}
 	for _, shop := range game.GetItemShops() {
-		var shopColor, borderColor rl.Color
-		switch shop.ItemType {
-		case entities.ItemTeleport:
-			shopColor, borderColor = TeleportShopColor, rl.Purple
-		case entities.ItemRepair:
-			shopColor, borderColor = RepairShopColor, rl.DarkGreen
-		case entities.ItemRefuel:
-			shopColor, borderColor = RefuelShopColor, rl.Orange
-		case entities.ItemBomb:
-			shopColor, borderColor = BombShopColor, rl.Maroon
-		case entities.ItemBigBomb:
-			shopColor, borderColor = BigBombShopColor, rl.Red
+		colors, ok := ItemShopColors[shop.Item.ID]
+		if !ok {
+			colors = DefaultItemShopColors
 		}
-		r.renderUpgradeShop(shop.AABB, shopColor, borderColor)
+		r.renderUpgradeShop(shop.AABB, colors[0], colors[1])
 	}
-	r.renderPlayer(game.GetPlayer())
+	r.renderPickups(game.GetPickups())
+	r.renderBombs(game.GetBombs())
+	r.renderPlayer(playerAABB)
 
 	rl.EndMode2D()
 
 	// === SCREEN SPACE (no camera, always visible) ===
-	r.renderDebugInfo(game.GetPlayer(), inputState)
+	if r.showMap {
+		r.renderMap(game.GetWorld(), game.GetPlayer())
+	} else {
+		r.renderDebugInfo(game.GetPlayer(), game.GetWorld().GetConfig(), game.GetOreDetectorCooldown(), inputState)
+		r.renderCargoPrompt(game.GetPendingOre())
+		r.renderHotbar(game.GetItemRegistry(), game.GetPlayer(), game.GetSelectedItem())
+		r.renderActiveEffects(game.GetPlayer())
+	}
 
 	rl.EndDrawing()
 }
@@ -170,9 +212,8 @@ func (r *RaylibRenderer) GetFrameTime() float32 {
 	return rl.GetFrameTime()
 }
 
-func (r *RaylibRenderer) renderPlayer(player *entities.Player) {
+func (r *RaylibRenderer) renderPlayer(aabb types.AABB) {
 	// Convert domain AABB to Raylib rendering
-	aabb := player.AABB
 	rlPos := rl.Vector2{X: aabb.X, Y: aabb.Y}
 	rlSize := rl.Vector2{X: aabb.Width, Y: aabb.Height}
 	rl.DrawRectangleV(rlPos, rlSize, PlayerColor)
@@ -233,57 +274,84 @@ func (r *RaylibRenderer) renderUpgradeShop(aabb types.AABB, fillColor, borderCol
 	)
 }
 
-func (r *RaylibRenderer) renderWorld(w *world.World) {
-	groundLevel := w.GetGroundLevel()
+// renderUpgradeQuote prints the shop's line, the next tier's price after trade-in and the sell-back refund
+func (r *RaylibRenderer) renderUpgradeQuote(game *engine.Game, shop *entities.UpgradeShop) {
+	const fontSize = 18
+	x, y := int32(shop.AABB.X)+8, int32(shop.AABB.Y)+8
+	tier := game.GetPlayer().Loadout[shop.Slot].Tier()
+
+	rl.DrawText(shop.Label, x, y, fontSize, rl.White)
+
+	next := "Max level"
+	if entry := shop.GetNext(tier); entry != nil {
+		next = fmt.Sprintf("E: %s $%d", entry.Component.Name(), entry.Price-game.GetTradeInCredit(shop))
+	}
+	rl.DrawText(next, x, y+24, fontSize, rl.White)
+
+	if _, ok := shop.GetPrevious(tier); ok {
+		rl.DrawText(fmt.Sprintf("R: sell back +$%d", game.GetResaleValue(shop)), x, y+48, fontSize, rl.LightGray)
+	}
+}
 
-	// Draw sky from off-screen above to ground level
+func (r *RaylibRenderer) renderWorld(w *world.World) {
+	// Draw sky from off-screen above down to the lowest possible surface
 	// Extended upward to cover viewport when camera is near top
 	skyTop := int32(-r.screenHeight)
-	skyHeight := int32(groundLevel) - skyTop
+	skyBottom := int32(w.GetGroundLevel() + w.GetConfig().SurfaceAmplitude*world.TileSize)
 
-	rl.DrawRectangle(0, skyTop, int32(w.Width), skyHeight, SkyColor)
+	rl.DrawRectangle(0, skyTop, int32(w.Width), skyBottom-skyTop, SkyColor)
 
-	// Draw ground (lower portion from groundLevel to world bottom)
-	rl.DrawRectangle(0, int32(groundLevel), int32(w.Width), int32(w.Height), GroundColor)
+	// Draw ground column by column from the local surface to world bottom
+	// Only visible columns are drawn since the surface height varies per column
+	minVisibleX, maxVisibleX, _, _ := r.visibleTileRange()
+	if minVisibleX < 0 {
+		minVisibleX = 0
+	}
+	for gridX := minVisibleX; gridX <= maxVisibleX; gridX++ {
+		surfaceY := int32(w.SurfaceTileY(gridX) * world.TileSize)
+		rl.DrawRectangle(int32(gridX*world.TileSize), surfaceY, world.TileSize, int32(w.Height)-surfaceY, GroundColor)
+	}
 }
 
-func (r *RaylibRenderer) renderTiles(w *world.World) {
-	tiles := w.GetAllTiles()
-
-	// Calculate visible tile range based on camera viewport
-	// Add 1-tile margin to prevent pop-in at edges
-	minVisibleX := int((r.camera.Target.X-r.screenWidth/2)/world.TileSize) - 1
-	maxVisibleX := int((r.camera.Target.X+r.screenWidth/2)/world.TileSize) + 1
-	minVisibleY := int((r.camera.Target.Y-r.screenHeight/2)/world.TileSize) - 1
-	maxVisibleY := int((r.camera.Target.Y+r.screenHeight/2)/world.TileSize) + 1
-
-	for coord, tile := range tiles {
-		gridX, gridY := coord[0], coord[1]
-
-		// Skip tiles outside viewport (culling optimization)
-		if gridX < minVisibleX || gridX > maxVisibleX ||
-			gridY < minVisibleY || gridY > maxVisibleY {
-			continue
+// visibleTileRange returns the grid rectangle covered by the camera viewport
+// A 1-tile margin prevents pop-in at edges
+func (r *RaylibRenderer) visibleTileRange() (minX, maxX, minY, maxY int) {
+	minX = int((r.camera.Target.X-r.screenWidth/2)/world.TileSize) - 1
+	maxX = int((r.camera.Target.X+r.screenWidth/2)/world.TileSize) + 1
+	minY = int((r.camera.Target.Y-r.screenHeight/2)/world.TileSize) - 1
+	maxY = int((r.camera.Target.Y+r.screenHeight/2)/world.TileSize) + 1
+	return
+}
+
+// tileColor returns the fill color for a tile, or false for tiles that aren't drawn
+func tileColor(tile *entities.Tile) (rl.Color, bool) {
+	switch tile.Type {
+	case entities.TileTypeEmpty:
+		return rl.Color{}, false
+	case entities.TileTypeDirt:
+		return DirtColor, true
+	case entities.TileTypeOre:
+		color, ok := OreColors[tile.OreType]
+		if !ok {
+			return rl.Magenta, true // Error color for unknown ore
 		}
+		return color, true
+	default:
+		return rl.Magenta, true // Error color for unknown tile type
+	}
+}
+
+func (r *RaylibRenderer) renderTiles(w *world.World) {
+	minVisibleX, maxVisibleX, minVisibleY, maxVisibleY := r.visibleTileRange()
 
+	// Only the viewport is queried, so cost scales with screen size, not explored area
+	w.ForEachTileInRect(minVisibleX, maxVisibleX, minVisibleY, maxVisibleY, func(gridX, gridY int, tile *entities.Tile) {
 		pixelX := float32(gridX * world.TileSize)
 		pixelY := float32(gridY * world.TileSize)
 
-		// Render tile based on type
-		var color rl.Color
-		switch tile.Type {
-		case entities.TileTypeEmpty:
-			continue // Skip empty tiles
-		case entities.TileTypeDirt:
-			color = DirtColor
-		case entities.TileTypeOre:
-			var ok bool
-			color, ok = OreColors[tile.OreType]
-			if !ok {
-				color = rl.Magenta // Error color for unknown ore
-			}
-		default:
-			color = rl.Magenta // Error color for unknown tile type
+		color, ok := tileColor(tile)
+		if !ok {
+			return // Skip empty tiles
 		}
 
 		// Draw filled tile
@@ -303,10 +371,162 @@ func (r *RaylibRenderer) renderTiles(w *world.World) {
 			world.TileSize,
 			GridColor,
 		)
+
+		// Cracks show damage remembered from earlier drilling
+		if damageRatio := w.TileDamageRatio(gridX, gridY); damageRatio > 0 {
+			renderTileCracks(pixelX, pixelY, damageRatio)
+		}
+	})
+}
+
+// renderFogOfWar covers every unexplored cell in the viewport with darkness
+// Empty cells are covered too, so caves aren't given away before they are seen
+func (r *RaylibRenderer) renderFogOfWar(w *world.World) {
+	minVisibleX, maxVisibleX, minVisibleY, maxVisibleY := r.visibleTileRange()
+
+	for gridX := minVisibleX; gridX <= maxVisibleX; gridX++ {
+		for gridY := minVisibleY; gridY <= maxVisibleY; gridY++ {
+			if w.IsExplored(gridX, gridY) {
+				continue
+			}
+
+			rl.DrawRectangle(
+				int32(gridX*world.TileSize),
+				int32(gridY*world.TileSize),
+				world.TileSize,
+				world.TileSize,
+				DarknessColor,
+			)
+		}
+	}
+}
+
+// renderOreDetections outlines ores found by the last detector scan
+// Drawn after the fog so detected ores show through unexplored rock
+func (r *RaylibRenderer) renderOreDetections(detections []systems.OreDetection) {
+	for _, detection := range detections {
+		color, ok := OreColors[detection.OreType]
+		if !ok {
+			color = rl.Magenta
+		}
+
+		rl.DrawRectangleLinesEx(
+			rl.Rectangle{
+				X:      float32(detection.GridX * world.TileSize),
+				Y:      float32(detection.GridY * world.TileSize),
+				Width:  world.TileSize,
+				Height: world.TileSize,
+			},
+			3.0,
+			color,
+		)
 	}
 }
 
-func (r *RaylibRenderer) renderDebugInfo(player *entities.Player, inputState input.InputState) {
+func (r *RaylibRenderer) renderPickups(pickups []*entities.Pickup) {
+	for _, pickup := range pickups {
+		color, ok := OreColors[pickup.OreType]
+		if !ok {
+			color = rl.Magenta
+		}
+
+		rect := rl.Rectangle{X: pickup.AABB.X, Y: pickup.AABB.Y, Width: pickup.AABB.Width, Height: pickup.AABB.Height}
+		rl.DrawRectangleRec(rect, color)
+		rl.DrawRectangleLinesEx(rect, 2.0, rl.Black)
+	}
+}
+
+// renderBombs draws placed bombs with their remaining fuse; the bomb flashes red in its last second
+func (r *RaylibRenderer) renderBombs(bombs []*entities.Bomb) {
+	for _, bomb := range bombs {
+		centerX, centerY := bomb.Center()
+		color := BombColor
+		if bomb.Fuse < 1.0 && int(bomb.Fuse*10)%2 == 0 {
+			color = rl.Red
+		}
+
+		rl.DrawCircle(int32(centerX), int32(centerY), bomb.AABB.Width/2, color)
+		rl.DrawText(fmt.Sprintf("%.0f", math.Ceil(float64(bomb.Fuse))), int32(centerX)-4, int32(bomb.AABB.Y)-18, 16, rl.White)
+	}
+}
+
+// renderTileCracks draws crack lines whose count grows with the tile's damage ratio
+func renderTileCracks(pixelX, pixelY, damageRatio float32) {
+	centerX := pixelX + world.TileSize/2
+	centerY := pixelY + world.TileSize/2
+
+	// Crack end points, revealed in order as damage accumulates
+	ends := [4]rl.Vector2{
+		{X: pixelX + 6, Y: pixelY + 10},
+		{X: pixelX + world.TileSize - 8, Y: pixelY + world.TileSize - 6},
+		{X: pixelX + world.TileSize - 10, Y: pixelY + 8},
+		{X: pixelX + 8, Y: pixelY + world.TileSize - 12},
+	}
+
+	cracks := int(damageRatio*float32(len(ends))) + 1
+	if cracks > len(ends) {
+		cracks = len(ends)
+	}
+
+	for i := 0; i < cracks; i++ {
+		rl.DrawLineEx(rl.Vector2{X: centerX, Y: centerY}, ends[i], 2.0, CrackColor)
+	}
+}
+
+// renderMap draws a scaled-down view of explored terrain centred on the player
+func (r *RaylibRenderer) renderMap(w *world.World, player *entities.Player) {
+	const mapTilePixels = 4 // screen pixels per tile on the map
+
+	rl.DrawRectangle(0, 0, int32(r.screenWidth), int32(r.screenHeight), DarknessColor)
+
+	tilesWide := int(r.screenWidth) / mapTilePixels
+	tilesHigh := int(r.screenHeight) / mapTilePixels
+	playerGridX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	playerGridY := int((player.AABB.Y + player.AABB.Height/2) / world.TileSize)
+
+	minX := playerGridX - tilesWide/2
+	minY := playerGridY - tilesHigh/2
+	maxX := minX + tilesWide
+	maxY := minY + tilesHigh
+
+	// Sky down to each column's surface (always visible)
+	for gridX := minX; gridX <= maxX; gridX++ {
+		surfaceRow := w.SurfaceTileY(gridX)
+		if surfaceRow > minY {
+			rl.DrawRectangle(int32((gridX-minX)*mapTilePixels), 0, mapTilePixels, int32((surfaceRow-minY)*mapTilePixels), SkyColor)
+		}
+	}
+
+	w.ForEachExploredCell(minX, maxX, minY, maxY, func(gridX, gridY int, tile *entities.Tile) {
+		color := CaveColor
+		if tile != nil {
+			if tileCol, ok := tileColor(tile); ok {
+				color = tileCol
+			}
+		}
+
+		rl.DrawRectangle(
+			int32((gridX-minX)*mapTilePixels),
+			int32((gridY-minY)*mapTilePixels),
+			mapTilePixels,
+			mapTilePixels,
+			color,
+		)
+	})
+
+	// Player marker
+	rl.DrawRectangle(
+		int32((playerGridX-minX)*mapTilePixels)-mapTilePixels/2,
+		int32((playerGridY-minY)*mapTilePixels)-mapTilePixels/2,
+		mapTilePixels*2,
+		mapTilePixels*2,
+		PlayerColor,
+	)
+
+	rl.DrawText("MAP (M to close)", 10, 10, 20, rl.White)
+}
+
+func (r *RaylibRenderer) renderDebugInfo(player *entities.Player, worldConfig world.Config, scanCooldown float32, inputState input.InputState) {
 	fontSize := int32(20)
 	textColor := rl.Black
 	lineHeight := int32(25)
@@ -344,29 +564,136 @@ func (r *RaylibRenderer) renderDebugInfo(player *entities.Player, inputState inp
 	// Draw player money, fuel, HP, and cargo
 	totalOre := player.GetTotalOreCount()
 	moneyFuelHPText := fmt.Sprintf("Money: $%d | Fuel: %.2fL | HP: %.1f | Cargo: %d/%d",
-		player.Money, player.Fuel, player.HP, totalOre, player.CargoHold.Capacity())
+		player.Money, player.Fuel, player.HP, totalOre, player.CargoHold().Capacity())
 	rl.DrawText(moneyFuelHPText, posX, posY, fontSize, textColor)
 	posY += lineHeight
 
 	// Draw upgrade levels
-	upgradeText := fmt.Sprintf("Upgrades: Engine=%d Hull=%d Tank=%d Cargo=%d Heat=%d Drill=%d",
-		player.Engine.Tier(), player.Hull.Tier(), player.FuelTank.Tier(), player.CargoHold.Tier(), player.HeatShield.Tier(), player.Drill.Tier())
+	upgradeText := fmt.Sprintf("Upgrades: Engine=%d Hull=%d Tank=%d Cargo=%d Heat=%d Drill=%d Detector=%d",
+		player.Engine().Tier(), player.Hull().Tier(), player.FuelTank().Tier(), player.CargoHold().Tier(), player.HeatShield().Tier(), player.Drill().Tier(), player.OreDetector().Tier())
 	rl.DrawText(upgradeText, posX, posY, fontSize, textColor)
 	posY += lineHeight
 
+	// Draw component wear (E at the repair shop restores it)
+	conditionText := fmt.Sprintf("Condition: Engine=%.0f%% Drill=%.0f%% Tank=%.0f%% (repair $%d)",
+		player.Engine().Condition()*100, player.Drill().Condition()*100, player.FuelTank().Condition()*100, player.RepairCost())
+	rl.DrawText(conditionText, posX, posY, fontSize, textColor)
+	posY += lineHeight
+
 	// Draw temperature
-	temperature := physics.CalculateTemperature(player.AABB.Y)
+	temperature := physics.CalculateTemperature(worldConfig, player.AABB.Y)
 	tempText := fmt.Sprintf("Temperature: %.1f°C (Resistance: %.1f°C)",
-		temperature, player.HeatShield.HeatResistance())
+		temperature, player.HeatResistance())
 	rl.DrawText(tempText, posX, posY, fontSize, textColor)
 	posY += lineHeight
 
-	// Draw item inventory
-	itemText := fmt.Sprintf("Items: Teleport=%d Repair=%d Fuel=%d Bomb=%d BigBomb=%d",
-		player.ItemInventory[entities.ItemTeleport],
-		player.ItemInventory[entities.ItemRepair],
-		player.ItemInventory[entities.ItemRefuel],
-		player.ItemInventory[entities.ItemBomb],
-		player.ItemInventory[entities.ItemBigBomb])
-	rl.DrawText(itemText, posX, posY, fontSize, textColor)
+	// Draw pressure
+	pressure := physics.CalculatePressure(worldConfig, player.AABB.Y)
+	pressureText := fmt.Sprintf("Pressure: %.0f atm (Hull rating: %.0f atm)",
+		pressure, player.Hull().PressureRating())
+	rl.DrawText(pressureText, posX, posY, fontSize, textColor)
+	posY += lineHeight
+
+	// Draw drill heat (overheated drills barely bite until cooled)
+	drillHeatText := fmt.Sprintf("Drill heat: %.0f%%", player.DrillHeat*100)
+	drillHeatColor := textColor
+	if player.DrillOverheated {
+		drillHeatText += " OVERHEATED"
+		drillHeatColor = rl.Red
+	}
+	rl.DrawText(drillHeatText, posX, posY, fontSize, drillHeatColor)
+	posY += lineHeight
+
+	// Draw ore detector status
+	scanText := "Scan (Q): ready"
+	if scanCooldown > 0 {
+		scanText = fmt.Sprintf("Scan (Q): %.1fs", scanCooldown)
+	}
+	scanText += fmt.Sprintf(" | Radius: %d tiles", player.OreDetector().ScanRadius())
+	rl.DrawText(scanText, posX, posY, fontSize, textColor)
+	posY += lineHeight
+
+	// Draw cargo policy and jettison controls
+	cargoText := fmt.Sprintf("Cargo full (C): %s | Jettison: 1-6", entities.CargoPolicyNames[player.CargoPolicy])
+	rl.DrawText(cargoText, posX, posY, fontSize, textColor)
+}
+
+// renderHotbar draws one slot per registered item along the bottom of the screen
+// The selected slot is outlined; [ and ] move the selection, Space uses it
+func (r *RaylibRenderer) renderHotbar(items *entities.ItemRegistry, player *entities.Player, selected entities.ItemID) {
+	const (
+		slotWidth  = 110
+		slotHeight = 44
+		slotGap    = 6
+		fontSize   = 14
+	)
+
+	ids := items.IDs()
+	totalWidth := int32(len(ids))*(slotWidth+slotGap) - slotGap
+	x := (int32(r.screenWidth) - totalWidth) / 2
+	y := int32(r.screenHeight) - slotHeight - 10
+
+	for _, id := range ids {
+		item, _ := items.Get(id)
+		rl.DrawRectangle(x, y, slotWidth, slotHeight, rl.Fade(rl.Black, 0.6))
+
+		borderColor, borderWidth := rl.Gray, float32(1)
+		if id == selected {
+			borderColor, borderWidth = rl.Yellow, 3
+		}
+		rl.DrawRectangleLinesEx(rl.Rectangle{X: float32(x), Y: float32(y), Width: slotWidth, Height: slotHeight}, borderWidth, borderColor)
+
+		rl.DrawText(item.Name, x+6, y+6, fontSize, rl.White)
+		rl.DrawText(fmt.Sprintf("x%d / %d", player.ItemInventory[id], item.StackLimit), x+6, y+24, fontSize, rl.LightGray)
+
+		x += slotWidth + slotGap
+	}
+}
+
+// renderActiveEffects lists status effects with their time left in the top-right corner
+// Debuffs are drawn in red
+func (r *RaylibRenderer) renderActiveEffects(player *entities.Player) {
+	const fontSize = 18
+
+	posY := int32(10)
+	for _, effect := range player.Status.Active() {
+		def := entities.StatusEffectDefinitions[effect.Kind]
+		text := fmt.Sprintf("%s %.0fs", def.Name, math.Ceil(float64(effect.Remaining)))
+		switch effect.Kind {
+		case entities.EffectShielded:
+			text += fmt.Sprintf(" (%.1f HP)", effect.Magnitude)
+		case entities.EffectBurning:
+			text += fmt.Sprintf(" (%.1f HP/s)", effect.Magnitude)
+		}
+
+		textColor := rl.White
+		if def.Debuff {
+			textColor = rl.Red
+		}
+
+		textWidth := rl.MeasureText(text, fontSize)
+		posX := int32(r.screenWidth) - textWidth - 10
+		rl.DrawText(text, posX, posY, fontSize, textColor)
+
+		// Bar shrinking with the time left
+		barWidth := float32(textWidth) * effect.Remaining / effect.Duration
+		rl.DrawRectangle(posX, posY+fontSize+2, int32(barWidth), 3, rl.Yellow)
+		posY += fontSize + 10
+	}
+}
+
+// renderCargoPrompt asks whether to keep ore dug with a full hold (CargoPolicyPrompt)
+func (r *RaylibRenderer) renderCargoPrompt(oreType entities.OreType, pending bool) {
+	if !pending {
+		return
+	}
+
+	text := fmt.Sprintf("Cargo full! Keep %s? (Y: drop least valuable / N: leave it)", entities.OreNames[oreType])
+	fontSize := int32(24)
+	textWidth := rl.MeasureText(text, fontSize)
+	posX := (int32(r.screenWidth) - textWidth) / 2
+	posY := int32(r.screenHeight) - 110 // Above the hotbar
+
+	rl.DrawRectangle(posX-10, posY-8, textWidth+20, fontSize+16, rl.NewColor(0, 0, 0, 180))
+	rl.DrawText(text, posX, posY, fontSize, rl.White)
 }
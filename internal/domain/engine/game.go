@@ -4,9 +4,20 @@ import (
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
 	"github.com/Kishlin/drill-game/internal/domain/systems"
+	"github.com/Kishlin/drill-game/internal/domain/types"
 	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
+const (
+	starterItemCount          = 1 // Starter kit: one of each item, so the hotbar can be tried before visiting a shop
+	upgradeShopsRightOfMarket = 6 // Upgrade shops that fit between the market and the item shops
+)
+
+const (
+	FixedTimestep = float32(1.0 / 120.0) // Seconds simulated by each Update tick (120 Hz)
+	MaxFrameTime  = 0.25                 // Longest frame Advance catches up on; hitches beyond are dropped
+)
+
 type Game struct {
 	world             *world.World
 	player            *entities.Player
@@ -16,83 +27,163 @@ type Game struct {
 	fuelSystem        *systems.FuelSystem
 	fuelStationSystem *systems.FuelStationSystem
 	hospitalSystem    *systems.HospitalSystem
+	repairSystem      *systems.RepairSystem
 	upgradeSystem     *systems.UpgradeSystem
 	itemSystem        *systems.ItemSystem
 	itemShopSystem    *systems.ItemShopSystem
+	explorationSystem *systems.ExplorationSystem
+	oreDetectorSystem *systems.OreDetectorSystem
+	cargoSystem       *systems.CargoSystem
+	pickupSystem      *systems.PickupSystem
+	bombSystem        *systems.BombSystem
+	effectSystem      *systems.EffectSystem
+
+	// Fixed-timestep state (see Advance)
+	accumulator        float32          // Frame time not yet simulated, always below FixedTimestep after Advance
+	ticks              uint64           // Fixed ticks simulated since the game started
+	previousPlayerAABB types.AABB       // Player AABB before the latest tick, for render interpolation
+	pendingInput       input.InputState // Presses from frames too short to run a tick
 }
 
 func NewGame(w *world.World) *Game {
-	// Spawn player at center of world horizontally, just above ground
+	// Spawn player at center of world horizontally, just above the local surface
 	spawnX := (w.Width / 2) - (entities.PlayerWidth / 2)
-	spawnY := w.GetGroundLevel() - entities.PlayerHeight - 10
+	spawnY := w.SurfaceYUnder(spawnX, entities.PlayerWidth) - entities.PlayerHeight - 10
 
+	// Buildings rest on the highest surface tile beneath their footprint
 	// Create market to the right of player spawn
 	marketX := spawnX + 200.0 // ~3 tiles to the right
-	marketY := w.GetGroundLevel() - entities.MarketHeight
+	marketY := w.SurfaceYUnder(marketX, entities.MarketWidth) - entities.MarketHeight
 	market := entities.NewMarket(marketX, marketY)
 
 	// Create fuel station to the left of player spawn
 	fuelStationX := spawnX - 520.0 // ~8 tiles to the left
-	fuelStationY := w.GetGroundLevel() - entities.FuelStationHeight
+	fuelStationY := w.SurfaceYUnder(fuelStationX, entities.FuelStationWidth) - entities.FuelStationHeight
 	fuelStation := entities.NewFuelStation(fuelStationX, fuelStationY)
 
 	// Create hospital to the left of fuel station
 	hospitalX := fuelStationX - 360.0 // ~5 tiles + gap to the left
-	hospitalY := w.GetGroundLevel() - entities.HospitalHeight
+	hospitalY := w.SurfaceYUnder(hospitalX, entities.HospitalWidth) - entities.HospitalHeight
 	hospital := entities.NewHospital(hospitalX, hospitalY)
 
-	// Create upgrade shops to the right of the ore market
-	upgradeShopY := w.GetGroundLevel() - entities.UpgradeShopHeight
-	engineShopX := marketX + 360.0
-	engineShop := entities.NewEngineUpgradeShop(engineShopX, upgradeShopY)
-
-	hullShopX := engineShopX + 360.0
-	hullShop := entities.NewHullUpgradeShop(hullShopX, upgradeShopY)
-
-	fuelTankShopX := hullShopX + 360.0
-	fuelTankShop := entities.NewFuelTankUpgradeShop(fuelTankShopX, upgradeShopY)
-
-	cargoHoldShopX := fuelTankShopX + 360.0
-	cargoHoldShop := entities.NewCargoHoldUpgradeShop(cargoHoldShopX, upgradeShopY)
-
-	heatShieldShopX := cargoHoldShopX + 360.0
-	heatShieldShop := entities.NewHeatShieldUpgradeShop(heatShieldShopX, upgradeShopY)
-
-	drillShopX := heatShieldShopX + 360.0
-	drillShop := entities.NewDrillUpgradeShop(drillShopX, upgradeShopY)
-
-	// Create item shops to the right of upgrade shops
-	itemShopY := w.GetGroundLevel() - entities.ItemShopHeight
-	teleportShopX := drillShopX + 200.0
-	teleportShop := entities.NewItemShop(teleportShopX, itemShopY, entities.ItemTeleport, 500, "Teleport")
-
-	repairShopX := teleportShopX + 200.0
-	repairShop := entities.NewItemShop(repairShopX, itemShopY, entities.ItemRepair, 200, "Repair Kit")
+	// Create one upgrade shop per component line: the first ones to the right of the ore market,
+	// the rest to the left of the hospital once the right side is full
+	upgradeShops := make([]*entities.UpgradeShop, 0, len(entities.ComponentLines))
+	rightShopX, leftShopX := marketX, hospitalX
+	for i, line := range entities.ComponentLines {
+		var shopX float32
+		if i < upgradeShopsRightOfMarket {
+			rightShopX += 360.0
+			shopX = rightShopX
+		} else {
+			leftShopX -= 360.0
+			shopX = leftShopX
+		}
+		upgradeShops = append(upgradeShops, entities.NewUpgradeShop(line, shopX, upgradeShopY(w, shopX)))
+	}
 
-	refuelShopX := repairShopX + 200.0
-	refuelShop := entities.NewItemShop(refuelShopX, itemShopY, entities.ItemRefuel, 100, "Fuel Can")
+	// Create repair shop past the last upgrade shop on the left
+	repairShopX := leftShopX - 360.0
+	repairShopY := w.SurfaceYUnder(repairShopX, entities.RepairShopWidth) - entities.RepairShopHeight
+	repairShop := entities.NewRepairShop(repairShopX, repairShopY)
+
+	// Create one item shop per registered item: to the right of the upgrade shops while they fit
+	// inside the world, the rest to the left of the repair shop
+	items := entities.NewDefaultItemRegistry()
+	itemShops := make([]*entities.ItemShop, 0, len(items.IDs()))
+	rightItemShopX := rightShopX + 360.0 - 200.0 // First shop clears the last upgrade shop
+	leftItemShopX := repairShopX
+	for _, id := range items.IDs() {
+		var shopX float32
+		if rightItemShopX+200.0+entities.ItemShopWidth <= w.Width {
+			rightItemShopX += 200.0
+			shopX = rightItemShopX
+		} else {
+			leftItemShopX -= 200.0
+			shopX = leftItemShopX
+		}
+		item, _ := items.Get(id)
+		itemShops = append(itemShops, entities.NewItemShop(shopX, itemShopY(w, shopX), item))
+	}
 
-	bombShopX := refuelShopX + 200.0
-	bombShop := entities.NewItemShop(bombShopX, itemShopY, entities.ItemBomb, 300, "Bomb")
+	cargoSystem := systems.NewCargoSystem()
+	pickupSystem := systems.NewPickupSystem(w)
+	bombSystem := systems.NewBombSystem(w, pickupSystem)
 
-	bigBombShopX := bombShopX + 200.0
-	bigBombShop := entities.NewItemShop(bigBombShopX, itemShopY, entities.ItemBigBomb, 800, "Big Bomb")
+	player := newPlayerWithStarterItems(spawnX, spawnY, items)
 
 	return &Game{
 		world:             w,
-		player:            entities.NewPlayer(spawnX, spawnY),
+		player:            player,
 		physicsSystem:     systems.NewPhysicsSystem(w),
-		drillingSystem:    systems.NewDrillingSystem(w),
+		drillingSystem:    systems.NewDrillingSystem(w, cargoSystem),
 		marketSystem:      systems.NewMarketSystem(market),
 		fuelSystem:        systems.NewFuelSystem(),
 		fuelStationSystem: systems.NewFuelStationSystem(fuelStation),
 		hospitalSystem:    systems.NewHospitalSystem(hospital),
-		upgradeSystem:     systems.NewUpgradeSystem(engineShop, hullShop, fuelTankShop, cargoHoldShop, heatShieldShop, drillShop),
-		itemSystem:        systems.NewItemSystem(w, spawnX, spawnY),
-		itemShopSystem:    systems.NewItemShopSystem(teleportShop, repairShop, refuelShop, bombShop, bigBombShop),
+		repairSystem:      systems.NewRepairSystem(repairShop),
+		upgradeSystem:     systems.NewUpgradeSystem(upgradeShops...),
+		itemSystem:        systems.NewItemSystem(items, bombSystem, spawnX, spawnY),
+		itemShopSystem:    systems.NewItemShopSystem(itemShops...),
+		explorationSystem: systems.NewExplorationSystem(w, systems.DefaultSightRadius),
+		oreDetectorSystem: systems.NewOreDetectorSystem(w),
+		cargoSystem:       cargoSystem,
+		pickupSystem:      pickupSystem,
+		bombSystem:        bombSystem,
+		effectSystem:      systems.NewEffectSystem(),
+
+		previousPlayerAABB: player.AABB,
+	}
+}
+
+// newPlayerWithStarterItems creates the player holding the starter kit (starterItemCount of each item)
+func newPlayerWithStarterItems(x, y float32, items *entities.ItemRegistry) *entities.Player {
+	player := entities.NewPlayer(x, y)
+	for _, id := range items.IDs() {
+		item, _ := items.Get(id)
+		for i := 0; i < starterItemCount; i++ {
+			player.AddItem(item)
+		}
+	}
+	return player
+}
+
+// upgradeShopY returns the Y that rests an upgrade shop at x on the terrain
+func upgradeShopY(w *world.World, x float32) float32 {
+	return w.SurfaceYUnder(x, entities.UpgradeShopWidth) - entities.UpgradeShopHeight
+}
+
+// itemShopY returns the Y that rests an item shop at x on the terrain
+func itemShopY(w *world.World, x float32) float32 {
+	return w.SurfaceYUnder(x, entities.ItemShopWidth) - entities.ItemShopHeight
+}
+
+// Advance runs as many FixedTimestep ticks as frameTime covers, carrying the remainder to the next frame
+// The simulation then only ever sees the same dt, whatever the frame rate
+// One-shot presses go to the first tick only; a frame too short for any tick keeps them for the next one
+func (g *Game) Advance(frameTime float32, inputState input.InputState) error {
+	g.accumulator += min(frameTime, MaxFrameTime)
+
+	tickInput := inputState.Merge(g.pendingInput)
+	g.pendingInput = tickInput.Presses() // Held keys always come from the latest frame
+
+	for g.accumulator >= FixedTimestep {
+		g.previousPlayerAABB = g.player.AABB
+
+		if err := g.Update(FixedTimestep, tickInput); err != nil {
+			return err
+		}
+
+		g.accumulator -= FixedTimestep
+		g.ticks++
+		tickInput = tickInput.Held()
+		g.pendingInput = input.InputState{}
 	}
+
+	return nil
 }
 
+// Update simulates one tick of dt seconds; the game loop calls it through Advance with FixedTimestep
 func (g *Game) Update(dt float32, inputState input.InputState) error {
 	// 0. Update chunks around player (proactive loading)
 	playerX := g.player.AABB.X + g.player.AABB.Width/2
@@ -106,30 +197,53 @@ func (g *Game) Update(dt float32, inputState input.InputState) error {
 	// 2. Always: fuel consumption (runs even during drilling animation)
 	g.fuelSystem.ConsumeFuel(g.player, inputState, dt)
 
-	// 3. Handle drilling (vertical + horizontal, with animation)
+	// 3. Handle drilling (all directions, with animation)
+	//    Cancelling clears IsDrilling this frame; chained drills keep it set between tiles
 	g.drillingSystem.ProcessDrilling(g.player, inputState, dt)
 
+	// 4. Always: cargo policy, prompt answers and jettison (usable mid-drill)
+	g.cargoSystem.ProcessCargo(g.player, inputState)
+
+	// 5. Always: bomb fuses, blasts and chain reactions (ore blasted loose becomes pickups)
+	g.bombSystem.UpdateBombs(g.player, dt)
+
+	// 6. Always: dropped ore becomes loose pickups that fall and are collected on contact
+	g.pickupSystem.SpawnDroppedOre(g.cargoSystem.TakeDroppedOre())
+	g.pickupSystem.UpdatePickups(g.player, dt)
+
+	// 7. Always: reveal terrain around the player (fog of war)
+	g.explorationSystem.RevealAroundPlayer(g.player)
+
+	// 8. Always: ore detector timers and scans (usable mid-drill)
+	g.oreDetectorSystem.ProcessScan(g.player, inputState, dt)
+
+	// 9. Always: timed effects from consumables wear off (keeps ticking mid-drill)
+	g.effectSystem.ProcessEffects(g.player, dt)
+
 	// Skip interactions during drilling animation
 	if g.player.IsDrilling {
 		return nil
 	}
 
-	// 4. Handle item usage
+	// 10. Handle item usage
 	g.itemSystem.ProcessItemUsage(g.player, inputState)
 
-	// 5. Handle market selling
+	// 11. Handle market selling
 	g.marketSystem.ProcessSelling(g.player, inputState)
 
-	// 6. Handle fuel station refueling
+	// 12. Handle fuel station refueling
 	g.fuelStationSystem.ProcessRefueling(g.player, inputState)
 
-	// 7. Handle hospital healing
+	// 13. Handle hospital healing
 	g.hospitalSystem.ProcessHealing(g.player, inputState)
 
-	// 8. Handle upgrade purchases
+	// 14. Handle component repairs
+	g.repairSystem.ProcessRepair(g.player, inputState)
+
+	// 15. Handle upgrade purchases
 	g.upgradeSystem.ProcessUpgrade(g.player, inputState)
 
-	// 9. Handle item shop purchases
+	// 16. Handle item shop purchases
 	g.itemShopSystem.ProcessPurchase(g.player, inputState)
 
 	return nil
@@ -143,6 +257,26 @@ func (g *Game) GetPlayer() *entities.Player {
 	return g.player
 }
 
+// GetTicks returns how many fixed ticks have been simulated
+func (g *Game) GetTicks() uint64 {
+	return g.ticks
+}
+
+// GetInterpolationAlpha returns how far the unsimulated frame time reaches into the next tick (0-1)
+func (g *Game) GetInterpolationAlpha() float32 {
+	return g.accumulator / FixedTimestep
+}
+
+// GetInterpolatedPlayerAABB blends the player's AABB before and after the latest tick by the
+// interpolation alpha, so motion renders smoothly when the frame rate and tick rate differ
+func (g *Game) GetInterpolatedPlayerAABB() types.AABB {
+	alpha := g.GetInterpolationAlpha()
+	aabb := g.player.AABB
+	aabb.X = g.previousPlayerAABB.X + (g.player.AABB.X-g.previousPlayerAABB.X)*alpha
+	aabb.Y = g.previousPlayerAABB.Y + (g.player.AABB.Y-g.previousPlayerAABB.Y)*alpha
+	return aabb
+}
+
 func (g *Game) GetMarket() *entities.Market {
 	return g.marketSystem.GetMarket()
 }
@@ -155,28 +289,50 @@ func (g *Game) GetHospital() *entities.Hospital {
 	return g.hospitalSystem.GetHospital()
 }
 
-func (g *Game) GetEngineShop() *entities.EngineUpgradeShop {
-	return g.upgradeSystem.GetEngineShop()
+func (g *Game) GetRepairShop() *entities.RepairShop {
+	return g.repairSystem.GetRepairShop()
+}
+
+func (g *Game) GetUpgradeShops() []*entities.UpgradeShop {
+	return g.upgradeSystem.GetShops()
+}
+
+// GetTradeInCredit returns what the player's installed component is worth toward shop's next tier
+func (g *Game) GetTradeInCredit(shop *entities.UpgradeShop) int {
+	return g.upgradeSystem.TradeInCredit(g.player, shop)
+}
+
+// GetResaleValue returns what selling the player's installed component back to shop refunds
+func (g *Game) GetResaleValue(shop *entities.UpgradeShop) int {
+	return g.upgradeSystem.ResaleValue(g.player, shop)
+}
+
+func (g *Game) GetOreDetections() []systems.OreDetection {
+	return g.oreDetectorSystem.GetDetections()
+}
+
+func (g *Game) GetOreDetectorCooldown() float32 {
+	return g.oreDetectorSystem.GetCooldownRemaining()
 }
 
-func (g *Game) GetHullShop() *entities.HullUpgradeShop {
-	return g.upgradeSystem.GetHullShop()
+func (g *Game) GetPendingOre() (entities.OreType, bool) {
+	return g.cargoSystem.GetPendingOre()
 }
 
-func (g *Game) GetFuelTankShop() *entities.FuelTankUpgradeShop {
-	return g.upgradeSystem.GetFuelTankShop()
+func (g *Game) GetPickups() []*entities.Pickup {
+	return g.pickupSystem.GetPickups()
 }
 
-func (g *Game) GetCargoHoldShop() *entities.CargoHoldUpgradeShop {
-	return g.upgradeSystem.GetCargoHoldShop()
+func (g *Game) GetBombs() []*entities.Bomb {
+	return g.bombSystem.GetBombs()
 }
 
-func (g *Game) GetHeatShieldShop() *entities.HeatShieldUpgradeShop {
-	return g.upgradeSystem.GetHeatShieldShop()
+func (g *Game) GetItemRegistry() *entities.ItemRegistry {
+	return g.itemSystem.GetRegistry()
 }
 
-func (g *Game) GetDrillShop() *entities.DrillUpgradeShop {
-	return g.upgradeSystem.GetDrillShop()
+func (g *Game) GetSelectedItem() entities.ItemID {
+	return g.itemSystem.Selected(g.player)
 }
 
 func (g *Game) GetItemShops() []*entities.ItemShop {
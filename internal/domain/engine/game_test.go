@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/types"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+func newTestGame() *Game {
+	return NewGame(world.NewWorld(7680, 64000, 640, 42))
+}
+
+// nextItem returns the hotbar item after current, wrapping around
+func nextItem(ids []entities.ItemID, current entities.ItemID) entities.ItemID {
+	for i, id := range ids {
+		if id == current {
+			return ids[(i+1)%len(ids)]
+		}
+	}
+	return ids[0]
+}
+
+func TestGame_PlayerStartsWithStarterKit(t *testing.T) {
+	game := newTestGame()
+
+	for _, id := range game.GetItemRegistry().IDs() {
+		if count := game.GetPlayer().ItemInventory[id]; count != starterItemCount {
+			t.Errorf("Expected %d of item %v in the starter kit, got %d", starterItemCount, id, count)
+		}
+	}
+}
+
+func TestGame_AdvanceRunsWholeFixedTicks(t *testing.T) {
+	game := newTestGame()
+
+	game.Advance(0.054, input.InputState{}) // 6.48 ticks at 120 Hz
+
+	if game.GetTicks() != 6 {
+		t.Errorf("Expected 6 ticks, got %d", game.GetTicks())
+	}
+	if alpha := game.GetInterpolationAlpha(); alpha < 0.47 || alpha > 0.49 {
+		t.Errorf("Expected the leftover 0.48 of a tick as alpha, got %f", alpha)
+	}
+}
+
+func TestGame_AdvanceClampsLongFrames(t *testing.T) {
+	game := newTestGame()
+
+	game.Advance(5.0, input.InputState{})
+
+	maxTicks := uint64(MaxFrameTime*120) + 1 // One spare tick for float rounding
+	if game.GetTicks() > maxTicks {
+		t.Errorf("A 5s hitch should only simulate %.2fs (%d ticks), got %d ticks", MaxFrameTime, maxTicks, game.GetTicks())
+	}
+}
+
+func TestGame_SimulationIsFrameRateIndependent(t *testing.T) {
+	fast := newTestGame()
+	slow := newTestGame()
+
+	// 1.03s either way: 100 frames at ~97 FPS vs 20 frames at ~19 FPS
+	for i := 0; i < 100; i++ {
+		fast.Advance(0.0103, input.InputState{Left: true})
+	}
+	for i := 0; i < 20; i++ {
+		slow.Advance(0.0515, input.InputState{Left: true})
+	}
+
+	if fast.GetTicks() != slow.GetTicks() {
+		t.Fatalf("Expected the same tick count, got %d and %d", fast.GetTicks(), slow.GetTicks())
+	}
+	if fast.GetPlayer().AABB != slow.GetPlayer().AABB || fast.GetPlayer().Fuel != slow.GetPlayer().Fuel {
+		t.Errorf("Expected identical player state, got %+v / %f and %+v / %f",
+			fast.GetPlayer().AABB, fast.GetPlayer().Fuel, slow.GetPlayer().AABB, slow.GetPlayer().Fuel)
+	}
+}
+
+func TestGame_InterpolatedAABBBlendsLastTick(t *testing.T) {
+	game := newTestGame()
+	game.Advance(0.054, input.InputState{}) // Falling onto the ground from the spawn point
+
+	previous := game.previousPlayerAABB
+	current := game.GetPlayer().AABB
+	alpha := game.GetInterpolationAlpha()
+
+	interpolated := game.GetInterpolatedPlayerAABB()
+	expectedY := previous.Y + (current.Y-previous.Y)*alpha
+	if interpolated.Y != expectedY || interpolated.Width != current.Width {
+		t.Errorf("Expected Y %f between %f and %f, got %+v", expectedY, previous.Y, current.Y, interpolated)
+	}
+}
+
+func TestGame_PressAppliesOnceAcrossTicks(t *testing.T) {
+	game := newTestGame()
+	ids := game.GetItemRegistry().IDs()
+	start := game.GetSelectedItem()
+
+	game.Advance(0.054, input.InputState{CycleItem: 1}) // Six ticks, one press
+
+	if game.GetSelectedItem() != nextItem(ids, start) {
+		t.Errorf("Expected the selection to move by one item, got %v from %v", game.GetSelectedItem(), start)
+	}
+}
+
+func TestGame_PressFromTicklessFrameCarriesOver(t *testing.T) {
+	game := newTestGame()
+	ids := game.GetItemRegistry().IDs()
+	start := game.GetSelectedItem()
+
+	game.Advance(0.001, input.InputState{CycleItem: 1}) // Too short for a tick
+	if game.GetTicks() != 0 || game.GetSelectedItem() != start {
+		t.Fatal("Expected no tick and no change yet")
+	}
+
+	game.Advance(0.01, input.InputState{})
+	if game.GetSelectedItem() != nextItem(ids, start) {
+		t.Errorf("Expected the earlier press to apply on the next tick, got %v from %v", game.GetSelectedItem(), start)
+	}
+}
+
+func TestGame_ReleasedKeyFromTicklessFrameDoesNotCarryOver(t *testing.T) {
+	game := newTestGame()
+	startX := game.GetPlayer().AABB.X
+
+	game.Advance(0.001, input.InputState{Right: true}) // Pressed and held, too short for a tick
+	game.Advance(0.01, input.InputState{})             // Released before the next tick
+
+	if game.GetTicks() == 0 {
+		t.Fatal("Expected the second frame to run a tick")
+	}
+	if x := game.GetPlayer().AABB.X; x != startX {
+		t.Errorf("Released key should not move the player, X went from %f to %f", startX, x)
+	}
+}
+
+func TestGame_BuildingsFitInsideWorld(t *testing.T) {
+	game := newTestGame()
+	worldWidth := game.GetWorld().Width
+
+	buildings := []types.AABB{
+		game.GetMarket().AABB,
+		game.GetFuelStation().AABB,
+		game.GetHospital().AABB,
+		game.GetRepairShop().AABB,
+	}
+	for _, shop := range game.GetUpgradeShops() {
+		buildings = append(buildings, shop.AABB)
+	}
+	for _, shop := range game.GetItemShops() {
+		buildings = append(buildings, shop.AABB)
+	}
+
+	for i, aabb := range buildings {
+		if aabb.X < 0 || aabb.X+aabb.Width > worldWidth {
+			t.Errorf("Building %d at x=%.0f..%.0f is outside the %.0f px world", i, aabb.X, aabb.X+aabb.Width, worldWidth)
+		}
+		for j := i + 1; j < len(buildings); j++ {
+			if aabb.Intersects(buildings[j]) {
+				t.Errorf("Buildings %d and %d overlap", i, j)
+			}
+		}
+	}
+}
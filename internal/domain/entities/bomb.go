@@ -0,0 +1,46 @@
+package entities
+
+import "github.com/Kishlin/drill-game/internal/domain/types"
+
+const (
+	BombSize = 20.0 // pixels (square)
+	BombFuse = 3.0  // seconds between placing a bomb and its detonation
+)
+
+// BombSpec describes a bomb's blast; damage and knockback are full strength at the center
+// and fall off linearly to zero at the edge of the radius
+type BombSpec struct {
+	Radius    int     // Blast radius in tiles
+	Damage    float32 // HP dealt to the player at the center of the blast
+	Knockback float32 // px/s added to the player's velocity at the center of the blast
+}
+
+// BombSpecs provides the blast for each placeable item
+var BombSpecs = map[ItemID]BombSpec{
+	ItemBomb:    {Radius: 2, Damage: 6, Knockback: 500},
+	ItemBigBomb: {Radius: 4, Damage: 12, Knockback: 800},
+}
+
+// Bomb is a placed explosive that falls like a pickup and detonates when its fuse runs out
+type Bomb struct {
+	AABB     types.AABB
+	Velocity types.Vec2
+	OnGround bool
+	Spec     BombSpec
+	Fuse     float32 // Seconds left before detonation
+}
+
+// NewBomb creates a bomb centered on the given pixel position with a full fuse
+func NewBomb(centerX, centerY float32, spec BombSpec) *Bomb {
+	return &Bomb{
+		AABB:     types.NewAABB(centerX-BombSize/2, centerY-BombSize/2, BombSize, BombSize),
+		Velocity: types.Zero(),
+		Spec:     spec,
+		Fuse:     BombFuse,
+	}
+}
+
+// Center returns the pixel center of the bomb, where its blast originates
+func (b *Bomb) Center() (float32, float32) {
+	return b.AABB.X + b.AABB.Width/2, b.AABB.Y + b.AABB.Height/2
+}
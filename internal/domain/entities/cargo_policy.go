@@ -0,0 +1,22 @@
+package entities
+
+// CargoPolicy decides what happens to newly dug ore when the cargo hold is full
+type CargoPolicy int
+
+const (
+	CargoPolicyDropLeastValuable CargoPolicy = iota // Drop the cheapest ore held to make room (if it is cheaper)
+	CargoPolicyPrompt                               // Ask the player whether to keep the new ore
+	CargoPolicyLeaveInWorld                         // Leave the new ore behind where it was dug
+)
+
+// CargoPolicyNames provides display names for each cargo policy
+var CargoPolicyNames = map[CargoPolicy]string{
+	CargoPolicyDropLeastValuable: "Drop Least Valuable",
+	CargoPolicyPrompt:            "Ask",
+	CargoPolicyLeaveInWorld:      "Leave In World",
+}
+
+// Next returns the policy that follows this one, wrapping around
+func (cp CargoPolicy) Next() CargoPolicy {
+	return (cp + 1) % CargoPolicy(len(CargoPolicyNames))
+}
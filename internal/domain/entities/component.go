@@ -0,0 +1,229 @@
+package entities
+
+import "fmt"
+
+// ComponentSlot identifies where a component is installed on the player
+type ComponentSlot int
+
+const (
+	SlotEngine ComponentSlot = iota
+	SlotHull
+	SlotFuelTank
+	SlotCargoHold
+	SlotHeatShield
+	SlotDrill
+	SlotOreDetector
+)
+
+// StatKey names a numeric stat carried by a component tier
+type StatKey string
+
+const (
+	StatMaxSpeed        StatKey = "max_speed"
+	StatAcceleration    StatKey = "acceleration"
+	StatFlyAcceleration StatKey = "fly_acceleration"
+	StatMaxUpwardSpeed  StatKey = "max_upward_speed" // Negative (up is -Y)
+	StatFuelEfficiency  StatKey = "fuel_efficiency"  // Fuel burn is divided by this (1 = base engine)
+	StatMaxHP           StatKey = "max_hp"
+	StatPressureRating  StatKey = "pressure_rating"  // Pressure (atm) the hull withstands without damage
+	StatShockAbsorption StatKey = "shock_absorption" // Share of impact damage the hull soaks up (0-1)
+	StatFuelCapacity    StatKey = "fuel_capacity"
+	StatCargoCapacity   StatKey = "cargo_capacity"
+	StatHeatResistance  StatKey = "heat_resistance"
+	StatDrillSpeed      StatKey = "drill_speed"
+	StatScanRadius      StatKey = "scan_radius"
+	StatScanCooldown    StatKey = "scan_cooldown"
+)
+
+const (
+	MalfunctionFloor = 0.5 // Share of a wear-affected stat left on a fully broken component
+	MaxFuelLeakRate  = 0.2 // Liters per second lost from a fully broken fuel tank
+)
+
+// Component is one installed tier of an upgrade line
+type Component struct {
+	slot      ComponentSlot
+	tier      int
+	name      string
+	stats     map[StatKey]float32
+	condition float32 // 1 = pristine, 0 = broken
+}
+
+func (c Component) Slot() ComponentSlot {
+	return c.slot
+}
+
+func (c Component) Tier() int {
+	return c.tier
+}
+
+func (c Component) Name() string {
+	return c.name
+}
+
+// Stat returns the value of key at this tier (0 if the line doesn't define it)
+func (c Component) Stat(key StatKey) float32 {
+	return c.stats[key]
+}
+
+// Condition returns how intact the component is, from 1 (pristine) down to 0 (broken)
+func (c Component) Condition() float32 {
+	return c.condition
+}
+
+// Performance returns the multiplier wear applies to the component's working stats
+// Falls linearly from 1 when pristine to MalfunctionFloor when broken
+func (c Component) Performance() float32 {
+	return MalfunctionFloor + (1-MalfunctionFloor)*c.condition
+}
+
+// Worn returns a copy with condition reduced by amount (never below 0)
+func (c Component) Worn(amount float32) Component {
+	c.condition = max(c.condition-amount, 0)
+	return c
+}
+
+// Repaired returns a pristine copy
+func (c Component) Repaired() Component {
+	c.condition = 1
+	return c
+}
+
+// ComponentTier is the data for one tier of an upgrade line
+type ComponentTier struct {
+	Name  string
+	Price int // Shop price; the base tier is never sold
+	Stats map[StatKey]float32
+}
+
+// ComponentLine describes an upgrade line: its slot, shop label and tiers from Base (0) upward
+type ComponentLine struct {
+	Slot  ComponentSlot
+	Label string
+	Tiers []ComponentTier
+}
+
+// MaxTier returns the highest tier of the line
+func (l ComponentLine) MaxTier() int {
+	return len(l.Tiers) - 1
+}
+
+// Component builds the component for tier
+func (l ComponentLine) Component(tier int) Component {
+	t := l.Tiers[tier]
+	return Component{slot: l.Slot, tier: tier, name: t.Name, stats: t.Stats, condition: 1}
+}
+
+// ComponentLines lists every upgrade line in shop order; adding a line here adds its shop
+var ComponentLines = []ComponentLine{
+	{Slot: SlotEngine, Label: "Engine", Tiers: []ComponentTier{
+		{Name: "Base Engine", Price: 0, Stats: engineStats(450, 2500, -600, 1.0)},
+		{Name: "Engine Mk1", Price: 100, Stats: engineStats(475, 2667, -635, 1.1)},
+		{Name: "Engine Mk2", Price: 300, Stats: engineStats(500, 2833, -670, 1.2)},
+		{Name: "Engine Mk3", Price: 750, Stats: engineStats(525, 3000, -705, 1.35)},
+		{Name: "Engine Mk4", Price: 1500, Stats: engineStats(562, 3250, -740, 1.5)},
+		{Name: "Engine Mk5", Price: 5000, Stats: engineStats(600, 3500, -775, 1.7)},
+	}},
+	{Slot: SlotHull, Label: "Hull", Tiers: []ComponentTier{
+		{Name: "Base Hull", Price: 0, Stats: hullStats(10, 400, 0)},
+		{Name: "Hull Mk1", Price: 150, Stats: hullStats(15, 500, 0.15)},
+		{Name: "Hull Mk2", Price: 400, Stats: hullStats(20, 600, 0.3)},
+		{Name: "Hull Mk3", Price: 1000, Stats: hullStats(30, 750, 0.45)},
+		{Name: "Hull Mk4", Price: 2500, Stats: hullStats(45, 900, 0.6)},
+		{Name: "Hull Mk5", Price: 8000, Stats: hullStats(75, 1000, 0.75)},
+	}},
+	{Slot: SlotFuelTank, Label: "Fuel Tank", Tiers: []ComponentTier{
+		{Name: "Base Tank", Price: 0, Stats: stat(StatFuelCapacity, 10)},
+		{Name: "Tank Mk1", Price: 100, Stats: stat(StatFuelCapacity, 15)},
+		{Name: "Tank Mk2", Price: 250, Stats: stat(StatFuelCapacity, 22)},
+		{Name: "Tank Mk3", Price: 600, Stats: stat(StatFuelCapacity, 32)},
+		{Name: "Tank Mk4", Price: 1500, Stats: stat(StatFuelCapacity, 45)},
+		{Name: "Tank Mk5", Price: 4000, Stats: stat(StatFuelCapacity, 65)},
+	}},
+	{Slot: SlotCargoHold, Label: "Cargo Hold", Tiers: []ComponentTier{
+		{Name: "Base Cargo Hold", Price: 0, Stats: stat(StatCargoCapacity, 10)},
+		{Name: "Cargo Hold Mk1", Price: 125, Stats: stat(StatCargoCapacity, 14)},
+		{Name: "Cargo Hold Mk2", Price: 350, Stats: stat(StatCargoCapacity, 18)},
+		{Name: "Cargo Hold Mk3", Price: 800, Stats: stat(StatCargoCapacity, 24)},
+		{Name: "Cargo Hold Mk4", Price: 2000, Stats: stat(StatCargoCapacity, 31)},
+		{Name: "Cargo Hold Mk5", Price: 6000, Stats: stat(StatCargoCapacity, 40)},
+	}},
+	{Slot: SlotHeatShield, Label: "Heat Shield", Tiers: []ComponentTier{
+		{Name: "Base Heat Shield", Price: 0, Stats: stat(StatHeatResistance, 50)},
+		{Name: "Heat Shield Mk1", Price: 200, Stats: stat(StatHeatResistance, 90)},
+		{Name: "Heat Shield Mk2", Price: 500, Stats: stat(StatHeatResistance, 140)},
+		{Name: "Heat Shield Mk3", Price: 1200, Stats: stat(StatHeatResistance, 190)},
+		{Name: "Heat Shield Mk4", Price: 3000, Stats: stat(StatHeatResistance, 250)},
+		{Name: "Heat Shield Mk5", Price: 7500, Stats: stat(StatHeatResistance, 320)},
+	}},
+	{Slot: SlotDrill, Label: "Drill", Tiers: []ComponentTier{
+		{Name: "Base Drill", Price: 0, Stats: stat(StatDrillSpeed, 1)},
+		{Name: "Drill Mk1", Price: 125, Stats: stat(StatDrillSpeed, 2)},
+		{Name: "Drill Mk2", Price: 350, Stats: stat(StatDrillSpeed, 3)},
+		{Name: "Drill Mk3", Price: 875, Stats: stat(StatDrillSpeed, 4)},
+		{Name: "Drill Mk4", Price: 2000, Stats: stat(StatDrillSpeed, 5)},
+		{Name: "Drill Mk5", Price: 6500, Stats: stat(StatDrillSpeed, 6)},
+	}},
+	{Slot: SlotOreDetector, Label: "Ore Detector", Tiers: []ComponentTier{
+		{Name: "Base Ore Detector", Price: 0, Stats: detectorStats(3, 20)},
+		{Name: "Ore Detector Mk1", Price: 300, Stats: detectorStats(5, 16)},
+		{Name: "Ore Detector Mk2", Price: 900, Stats: detectorStats(7, 13)},
+		{Name: "Ore Detector Mk3", Price: 2500, Stats: detectorStats(9, 10)},
+		{Name: "Ore Detector Mk4", Price: 6000, Stats: detectorStats(12, 8)},
+		{Name: "Ore Detector Mk5", Price: 15000, Stats: detectorStats(16, 6)},
+	}},
+}
+
+// LineFor returns the upgrade line installed in slot
+func LineFor(slot ComponentSlot) ComponentLine {
+	for _, line := range ComponentLines {
+		if line.Slot == slot {
+			return line
+		}
+	}
+	panic(fmt.Sprintf("no component line for slot %d", slot))
+}
+
+// NewComponent builds the component of slot at tier
+func NewComponent(slot ComponentSlot, tier int) Component {
+	return LineFor(slot).Component(tier)
+}
+
+// Loadout holds the component installed in each slot
+type Loadout map[ComponentSlot]Component
+
+// NewBaseLoadout returns every line at its base tier
+func NewBaseLoadout() Loadout {
+	loadout := make(Loadout, len(ComponentLines))
+	for _, line := range ComponentLines {
+		loadout[line.Slot] = line.Component(0)
+	}
+	return loadout
+}
+
+func stat(key StatKey, value float32) map[StatKey]float32 {
+	return map[StatKey]float32{key: value}
+}
+
+// engineStats uses the same acceleration on the ground and in flight
+func engineStats(maxSpeed, acceleration, maxUpwardSpeed, fuelEfficiency float32) map[StatKey]float32 {
+	return map[StatKey]float32{
+		StatMaxSpeed:        maxSpeed,
+		StatAcceleration:    acceleration,
+		StatFlyAcceleration: acceleration,
+		StatMaxUpwardSpeed:  maxUpwardSpeed,
+		StatFuelEfficiency:  fuelEfficiency,
+	}
+}
+
+func hullStats(maxHP, pressureRating, shockAbsorption float32) map[StatKey]float32 {
+	return map[StatKey]float32{
+		StatMaxHP:           maxHP,
+		StatPressureRating:  pressureRating,
+		StatShockAbsorption: shockAbsorption,
+	}
+}
+
+func detectorStats(scanRadius, cooldown float32) map[StatKey]float32 {
+	return map[StatKey]float32{StatScanRadius: scanRadius, StatScanCooldown: cooldown}
+}
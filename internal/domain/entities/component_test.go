@@ -0,0 +1,99 @@
+package entities
+
+import "testing"
+
+func TestComponent_BaseLoadoutHasEveryLineAtTierZero(t *testing.T) {
+	loadout := NewBaseLoadout()
+
+	for _, line := range ComponentLines {
+		c, ok := loadout[line.Slot]
+		if !ok {
+			t.Fatalf("Base loadout is missing %s", line.Label)
+		}
+		if c.Tier() != 0 || c.Slot() != line.Slot {
+			t.Errorf("Expected %s at tier 0, got tier %d in slot %d", line.Label, c.Tier(), c.Slot())
+		}
+	}
+}
+
+func TestComponent_TypedViewsReadStats(t *testing.T) {
+	player := NewPlayer(0, 0)
+
+	if player.Engine().MaxSpeed() != 450 || player.Engine().MaxUpwardSpeed() != -600 {
+		t.Errorf("Unexpected base engine stats: %f, %f", player.Engine().MaxSpeed(), player.Engine().MaxUpwardSpeed())
+	}
+	if player.CargoHold().Capacity() != 10 {
+		t.Errorf("Expected base cargo capacity 10, got %d", player.CargoHold().Capacity())
+	}
+	if player.HP != player.Hull().MaxHP() || player.Fuel != player.FuelTank().Capacity() {
+		t.Error("New player should start with full HP and fuel")
+	}
+}
+
+func TestUpgradeShop_SellsTiersAboveBase(t *testing.T) {
+	line := LineFor(SlotDrill)
+	shop := NewUpgradeShop(line, 0, 0)
+
+	if len(shop.Catalog) != line.MaxTier() {
+		t.Fatalf("Expected %d catalog entries, got %d", line.MaxTier(), len(shop.Catalog))
+	}
+	next := shop.GetNext(0)
+	if next == nil || next.Component.Tier() != 1 || next.Price != line.Tiers[1].Price {
+		t.Errorf("Expected tier 1 at its line price, got %+v", next)
+	}
+	if shop.GetNext(line.MaxTier()) != nil {
+		t.Error("No entry should follow the max tier")
+	}
+}
+
+func TestUpgradeShop_NewLineIsData(t *testing.T) {
+	const slotWinch ComponentSlot = 99
+	line := ComponentLine{Slot: slotWinch, Label: "Winch", Tiers: []ComponentTier{
+		{Name: "Base Winch", Stats: map[StatKey]float32{"pull": 1}},
+		{Name: "Winch Mk1", Price: 50, Stats: map[StatKey]float32{"pull": 2}},
+	}}
+	player := NewPlayer(0, 0)
+	player.Loadout[slotWinch] = line.Component(0)
+
+	entry := NewUpgradeShop(line, 0, 0).GetNext(player.Loadout[slotWinch].Tier())
+	player.BuyComponent(entry.Component, entry.Price, 0)
+
+	if player.Loadout[slotWinch].Stat("pull") != 2 {
+		t.Errorf("Expected the bought winch to pull 2, got %f", player.Loadout[slotWinch].Stat("pull"))
+	}
+	if player.Money != 100000-50 {
+		t.Errorf("Expected the winch price to be charged, got %d", player.Money)
+	}
+}
+
+func TestComponent_WearLowersPerformanceDownToFloor(t *testing.T) {
+	c := NewComponent(SlotEngine, 0)
+
+	if c.Condition() != 1 || c.Performance() != 1 {
+		t.Fatalf("New component should be pristine, got condition %f", c.Condition())
+	}
+	worn := c.Worn(0.5)
+	if worn.Performance() != MalfunctionFloor+(1-MalfunctionFloor)*0.5 {
+		t.Errorf("Unexpected performance at half condition: %f", worn.Performance())
+	}
+	if broken := worn.Worn(5); broken.Condition() != 0 || broken.Performance() != MalfunctionFloor {
+		t.Errorf("Expected condition clamped at 0, got %f", broken.Condition())
+	}
+	if c.Condition() != 1 {
+		t.Error("Worn should not modify the original component")
+	}
+	if worn.Repaired().Condition() != 1 {
+		t.Error("Repaired component should be pristine")
+	}
+}
+
+func TestComponent_WornTankLeaks(t *testing.T) {
+	tank := FuelTank{NewComponent(SlotFuelTank, 0)}
+	if tank.LeakRate() != 0 {
+		t.Errorf("Pristine tank should not leak, got %f", tank.LeakRate())
+	}
+	tank = FuelTank{tank.Worn(1)}
+	if tank.LeakRate() != MaxFuelLeakRate {
+		t.Errorf("Broken tank should leak %f, got %f", MaxFuelLeakRate, tank.LeakRate())
+	}
+}
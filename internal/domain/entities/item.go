@@ -1,20 +1,131 @@
 package entities
 
-type ItemType int
+import "github.com/Kishlin/drill-game/internal/domain/types"
 
+// ItemID identifies an item definition in an ItemRegistry (stable across saves)
+type ItemID string
+
+const (
+	ItemTeleport   ItemID = "teleport"
+	ItemRepair     ItemID = "repair"
+	ItemRefuel     ItemID = "refuel"
+	ItemBomb       ItemID = "bomb"
+	ItemBigBomb    ItemID = "big_bomb"
+	ItemParachute  ItemID = "parachute"
+	ItemShield     ItemID = "shield"
+	ItemDrillBoost ItemID = "drill_booster"
+	ItemCoolant    ItemID = "coolant"
+	ItemFlare      ItemID = "flare"
+)
+
+// Consumable effect tuning (durations in seconds)
 const (
-	ItemTeleport ItemType = iota
-	ItemRepair
-	ItemRefuel
-	ItemBomb
-	ItemBigBomb
+	ParachuteDuration    = 15.0
+	ShieldDuration       = 60.0
+	ShieldAbsorb         = 10.0 // HP absorbed before the shield breaks
+	DrillBoostDuration   = 30.0
+	DrillBoostMultiplier = 2.0
+	CoolantDuration      = 45.0
+	CoolantResistance    = 60.0 // °C added to heat resistance
+	FlareDuration        = 20.0
+	FlareSightBonus      = 5.0 // Tiles added to the sight radius
 )
 
-// ItemNames provides display names for each item type
-var ItemNames = map[ItemType]string{
-	ItemTeleport: "Teleport",
-	ItemRepair:   "Repair Kit",
-	ItemRefuel:   "Fuel Can",
-	ItemBomb:     "Bomb",
-	ItemBigBomb:  "Big Bomb",
+// ItemEnvironment is what item effects can reach beyond the player (implemented by systems.ItemSystem)
+type ItemEnvironment interface {
+	SpawnPoint() (float32, float32)
+	PlaceBomb(spec BombSpec, centerX, centerY float32)
+}
+
+// ItemEffect applies an item; returning false leaves the item in the inventory
+type ItemEffect func(player *Player, env ItemEnvironment) bool
+
+// ItemDefinition describes everything the game needs to sell, carry and use an item
+type ItemDefinition struct {
+	ID         ItemID
+	Name       string
+	Price      int
+	StackLimit int // Maximum carried at once
+	Use        ItemEffect
+}
+
+// ItemRegistry holds item definitions in hotbar and shop order
+type ItemRegistry struct {
+	definitions map[ItemID]ItemDefinition
+	order       []ItemID
+}
+
+func NewItemRegistry() *ItemRegistry {
+	return &ItemRegistry{definitions: make(map[ItemID]ItemDefinition)}
+}
+
+// Register adds an item, or replaces the definition with the same ID in place
+func (r *ItemRegistry) Register(def ItemDefinition) {
+	if _, exists := r.definitions[def.ID]; !exists {
+		r.order = append(r.order, def.ID)
+	}
+	r.definitions[def.ID] = def
+}
+
+// Get returns the definition for id
+func (r *ItemRegistry) Get(id ItemID) (ItemDefinition, bool) {
+	def, ok := r.definitions[id]
+	return def, ok
+}
+
+// IDs returns every registered item in registration order
+func (r *ItemRegistry) IDs() []ItemID {
+	return r.order
+}
+
+// NewDefaultItemRegistry returns the registry with the game's built-in items
+func NewDefaultItemRegistry() *ItemRegistry {
+	r := NewItemRegistry()
+	r.Register(ItemDefinition{ID: ItemTeleport, Name: "Teleport", Price: 500, StackLimit: 5, Use: useTeleport})
+	r.Register(ItemDefinition{ID: ItemRepair, Name: "Repair Kit", Price: 200, StackLimit: 5, Use: useRepair})
+	r.Register(ItemDefinition{ID: ItemRefuel, Name: "Fuel Can", Price: 100, StackLimit: 5, Use: useRefuel})
+	r.Register(ItemDefinition{ID: ItemBomb, Name: "Bomb", Price: 300, StackLimit: 10, Use: placeBomb(ItemBomb)})
+	r.Register(ItemDefinition{ID: ItemBigBomb, Name: "Big Bomb", Price: 800, StackLimit: 5, Use: placeBomb(ItemBigBomb)})
+	r.Register(ItemDefinition{ID: ItemParachute, Name: "Parachute", Price: 150, StackLimit: 3, Use: startEffect(EffectParachute, ParachuteDuration, 0)})
+	r.Register(ItemDefinition{ID: ItemShield, Name: "Shield", Price: 400, StackLimit: 3, Use: startEffect(EffectShielded, ShieldDuration, ShieldAbsorb)})
+	r.Register(ItemDefinition{ID: ItemDrillBoost, Name: "Drill Booster", Price: 350, StackLimit: 3, Use: startEffect(EffectBoosted, DrillBoostDuration, DrillBoostMultiplier)})
+	r.Register(ItemDefinition{ID: ItemCoolant, Name: "Coolant", Price: 250, StackLimit: 3, Use: startEffect(EffectCoolant, CoolantDuration, CoolantResistance)})
+	r.Register(ItemDefinition{ID: ItemFlare, Name: "Flare", Price: 50, StackLimit: 10, Use: startEffect(EffectFlare, FlareDuration, FlareSightBonus)})
+	return r
+}
+
+// useTeleport returns the player to the spawn point at ground level
+func useTeleport(player *Player, env ItemEnvironment) bool {
+	player.AABB.X, player.AABB.Y = env.SpawnPoint()
+	player.Velocity = types.Zero()
+	player.OnGround = false
+	return true
+}
+
+// useRepair restores HP to max instantly
+func useRepair(player *Player, _ ItemEnvironment) bool {
+	player.HP = player.Hull().MaxHP()
+	return true
+}
+
+// useRefuel fills the tank to max instantly
+func useRefuel(player *Player, _ ItemEnvironment) bool {
+	player.Fuel = player.FuelTank().Capacity()
+	return true
+}
+
+// placeBomb returns an effect dropping a lit bomb with the item's BombSpecs entry at the player's center
+func placeBomb(id ItemID) ItemEffect {
+	return func(player *Player, env ItemEnvironment) bool {
+		env.PlaceBomb(BombSpecs[id], player.AABB.X+player.AABB.Width/2, player.AABB.Y+player.AABB.Height/2)
+		return true
+	}
+}
+
+// startEffect returns an effect applying a status to the player (stacking by the status's rule)
+func startEffect(kind EffectKind, duration, magnitude float32) ItemEffect {
+	return func(player *Player, _ ItemEnvironment) bool {
+		player.Status.Apply(NewStatusEffect(kind, duration, magnitude))
+		return true
+	}
 }
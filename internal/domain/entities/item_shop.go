@@ -7,19 +7,16 @@ const (
 	ItemShopHeight = 192.0
 )
 
+// ItemShop sells a single item; name, price and stack limit come from its definition
 type ItemShop struct {
-	AABB     types.AABB
-	ItemType ItemType
-	Price    int
-	Name     string
+	AABB types.AABB
+	Item ItemDefinition
 }
 
-func NewItemShop(x, y float32, itemType ItemType, price int, name string) *ItemShop {
+func NewItemShop(x, y float32, item ItemDefinition) *ItemShop {
 	return &ItemShop{
-		AABB:     types.NewAABB(x, y, ItemShopWidth, ItemShopHeight),
-		ItemType: itemType,
-		Price:    price,
-		Name:     name,
+		AABB: types.NewAABB(x, y, ItemShopWidth, ItemShopHeight),
+		Item: item,
 	}
 }
 
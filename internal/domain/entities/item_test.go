@@ -0,0 +1,66 @@
+package entities
+
+import "testing"
+
+func TestItemRegistry_KeepsRegistrationOrder(t *testing.T) {
+	r := NewItemRegistry()
+	r.Register(ItemDefinition{ID: "a", Name: "A"})
+	r.Register(ItemDefinition{ID: "b", Name: "B"})
+	r.Register(ItemDefinition{ID: "a", Name: "A2"}) // Replacing keeps the original slot
+
+	ids := r.IDs()
+	if len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Fatalf("Expected [a b], got %v", ids)
+	}
+
+	def, ok := r.Get("a")
+	if !ok || def.Name != "A2" {
+		t.Errorf("Expected replaced definition A2, got %+v", def)
+	}
+	if _, ok := r.Get("missing"); ok {
+		t.Error("Unknown ID should not be found")
+	}
+}
+
+func TestDefaultItemRegistry_BuiltInItems(t *testing.T) {
+	r := NewDefaultItemRegistry()
+
+	for _, id := range []ItemID{ItemTeleport, ItemRepair, ItemRefuel, ItemBomb, ItemBigBomb} {
+		def, ok := r.Get(id)
+		if !ok {
+			t.Errorf("Missing built-in item %q", id)
+			continue
+		}
+		if def.Name == "" || def.Price <= 0 || def.StackLimit <= 0 || def.Use == nil {
+			t.Errorf("Incomplete definition for %q: %+v", id, def)
+		}
+	}
+}
+
+func TestPlayer_AddItem_RespectsStackLimit(t *testing.T) {
+	player := NewPlayer(0, 0)
+	item := ItemDefinition{ID: "rope", StackLimit: 2}
+
+	if !player.AddItem(item) || !player.AddItem(item) {
+		t.Fatal("Expected the first two to fit")
+	}
+	if player.AddItem(item) {
+		t.Error("Third should be refused at the stack limit")
+	}
+	if player.ItemInventory["rope"] != 2 {
+		t.Errorf("Expected 2 ropes, got %d", player.ItemInventory["rope"])
+	}
+}
+
+func TestPlayer_UseItem(t *testing.T) {
+	player := NewPlayer(0, 0)
+
+	if player.UseItem(ItemRepair) {
+		t.Error("Using an item the player doesn't have should fail")
+	}
+
+	player.ItemInventory[ItemRepair] = 1
+	if !player.UseItem(ItemRepair) || player.ItemInventory[ItemRepair] != 0 {
+		t.Errorf("Expected the repair kit used up, got %d", player.ItemInventory[ItemRepair])
+	}
+}
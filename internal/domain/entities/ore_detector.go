@@ -0,0 +1,16 @@
+package entities
+
+// OreDetector is a typed view over the component in SlotOreDetector
+type OreDetector struct {
+	Component
+}
+
+// ScanRadius returns the scan radius in tiles
+func (od OreDetector) ScanRadius() int {
+	return int(od.Stat(StatScanRadius))
+}
+
+// Cooldown returns the seconds between scans
+func (od OreDetector) Cooldown() float32 {
+	return od.Stat(StatScanCooldown)
+}
@@ -39,6 +39,16 @@ var OreValues = map[OreType]int{
 	OreDiamond:  30000,
 }
 
+// OreNames provides display names for each ore type
+var OreNames = map[OreType]string{
+	OreCopper:   "Copper",
+	OreIron:     "Iron",
+	OreGold:     "Gold",
+	OreMythril:  "Mythril",
+	OrePlatinum: "Platinum",
+	OreDiamond:  "Diamond",
+}
+
 // OreHardness maps each ore type to its drilling difficulty multiplier
 // Applied to base dirt drilling time at the same depth
 var OreHardness = map[OreType]float32{
@@ -50,6 +60,17 @@ var OreHardness = map[OreType]float32{
 	OreDiamond:  3.0,
 }
 
+// OreMass maps each ore type to the mass of one unit in kg
+// Heavier cargo slows the vehicle, burns more fuel and hurts more on landing
+var OreMass = map[OreType]float32{
+	OreCopper:   40,
+	OreIron:     50,
+	OreGold:     70,
+	OreMythril:  35,
+	OrePlatinum: 80,
+	OreDiamond:  25,
+}
+
 // GetAllOreTypes returns all ore types for iteration
 func GetAllOreTypes() []OreType {
 	return []OreType{
@@ -72,3 +93,14 @@ func CalculateInventoryValue(inventory [6]int) int {
 	}
 	return total
 }
+
+// CalculateInventoryMass calculates total mass in kg of an ore inventory
+func CalculateInventoryMass(inventory [6]int) float32 {
+	var total float32
+	for oreType, count := range inventory {
+		if count > 0 {
+			total += OreMass[OreType(oreType)] * float32(count)
+		}
+	}
+	return total
+}
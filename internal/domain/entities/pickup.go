@@ -0,0 +1,34 @@
+package entities
+
+import "github.com/Kishlin/drill-game/internal/domain/types"
+
+const (
+	PickupSize         = 24.0 // pixels (square)
+	PickupCollectDelay = 1.0  // seconds before a fresh pickup can be collected
+)
+
+// Pickup is a loose piece of ore lying in the world (dropped, jettisoned or blasted loose)
+type Pickup struct {
+	AABB         types.AABB
+	Velocity     types.Vec2
+	OnGround     bool
+	OreType      OreType
+	CollectDelay float32 // Seconds left before the player can collect it
+	AwaitsClear  bool    // Dropped from the hold: not collectable until the player has stopped touching it once
+}
+
+// NewOrePickup creates a pickup centered on the given pixel position
+// The collect delay stops the player from instantly re-collecting ore it just dropped
+func NewOrePickup(centerX, centerY float32, oreType OreType) *Pickup {
+	return &Pickup{
+		AABB:         types.NewAABB(centerX-PickupSize/2, centerY-PickupSize/2, PickupSize, PickupSize),
+		Velocity:     types.Zero(),
+		OreType:      oreType,
+		CollectDelay: PickupCollectDelay,
+	}
+}
+
+// CanBeCollected reports whether the collect delay has run out and the player has moved clear of a dropped pickup
+func (p *Pickup) CanBeCollected() bool {
+	return p.CollectDelay <= 0 && !p.AwaitsClear
+}
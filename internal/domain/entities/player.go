@@ -9,49 +9,43 @@ import (
 const (
 	PlayerWidth  = 54.0
 	PlayerHeight = 54.0
+	PlayerMass   = 1500.0 // kg, empty vehicle (cargo adds OreMass per ore)
 )
 
 type Player struct {
-	AABB         types.AABB // Position and dimensions
-	Velocity     types.Vec2 // Pixels per second
-	OnGround     bool       // Collision state
-	IsDrilling   bool       // Drilling animation state
-	OreInventory  [6]int    // Ore counts indexed by OreType
-	ItemInventory [5]int    // Item counts indexed by ItemType
-	Money         int       // Player's currency from selling ores
-	Fuel         float32    // Current fuel in liters
-	HP           float32    // Current hit points
-	Engine       Engine     // Engine component (exported)
-	Hull         Hull       // Hull component (exported)
-	FuelTank     FuelTank   // FuelTank component (exported)
-	CargoHold    CargoHold  // CargoHold component (exported)
-	HeatShield   HeatShield // HeatShield component (exported)
-	Drill        Drill      // Drill component (exported)
+	AABB            types.AABB          // Position and dimensions
+	Velocity        types.Vec2          // Pixels per second
+	OnGround        bool                // Collision state
+	IsDrilling      bool                // Drilling animation state
+	IsHovering      bool                // Engine holding altitude during a mid-air drill
+	DrillHardness   float32             // Hit points of the tile being drilled (0 when not drilling)
+	DrillHeat       float32             // Drill heat from 0 (cool) to 1 (overheated)
+	DrillOverheated bool                // Set at full heat, cleared once the drill cools to the resume level
+	OreInventory    [6]int              // Ore counts indexed by OreType
+	ItemInventory   map[ItemID]int      // Item counts keyed by item ID
+	SelectedItem    ItemID              // Hotbar selection used by the use-item key
+	Money           int                 // Player's currency from selling ores
+	Fuel            float32             // Current fuel in liters
+	HP              float32             // Current hit points
+	Loadout         Loadout             // Installed component per slot
+	Purchases       []ComponentPurchase // Price paid for each component bought, oldest first
+	CargoPolicy     CargoPolicy         // What to do with new ore when the hold is full
+	Status          StatusEffects       // Timed buffs and debuffs (consumables, hazards)
 }
 
 func NewPlayer(startX, startY float32) *Player {
-	engine := NewEngineBase()
-	hull := NewHullBase()
-	fuelTank := NewFuelTankBase()
-	cargoHold := NewCargoHoldBase()
-	heatShield := NewHeatShieldBase()
-	drill := NewDrillBase()
+	loadout := NewBaseLoadout()
 
 	return &Player{
 		AABB:          types.NewAABB(startX, startY, PlayerWidth, PlayerHeight),
 		Velocity:      types.Zero(),
 		OnGround:      false,
 		OreInventory:  [6]int{},
-		ItemInventory: [5]int{5, 5, 5, 5, 5}, // Start with 5 of each item for testing
-		Fuel:          fuelTank.Capacity(),
-		HP:           hull.MaxHP(),
-		Engine:       engine,
-		Hull:         hull,
-		FuelTank:     fuelTank,
-		CargoHold:    cargoHold,
-		HeatShield:   heatShield,
-		Drill:        drill,
-		Money:        100000,
+		ItemInventory: make(map[ItemID]int),
+		Fuel:          FuelTank{loadout[SlotFuelTank]}.Capacity(),
+		HP:            Hull{loadout[SlotHull]}.MaxHP(),
+		Loadout:       loadout,
+		Money:         100000,
 	}
 }
 
@@ -61,39 +55,131 @@ func (p *Player) CanAfford(cost int) bool {
 	return p.Money >= cost
 }
 
-func (p *Player) BuyEngine(e Engine, cost int) {
-	p.Money -= cost
-	p.Engine = e
+// ComponentPurchase records the price paid for one component tier
+type ComponentPurchase struct {
+	Slot  ComponentSlot
+	Tier  int
+	Price int
 }
 
-func (p *Player) BuyHull(h Hull, cost int) {
-	p.Money -= cost
-	p.Hull = h
+// BuyComponent installs c, charging price less the tradeIn credit for the component it replaces
+func (p *Player) BuyComponent(c Component, price, tradeIn int) {
+	p.Money -= price - tradeIn
+	p.Loadout[c.Slot()] = c
+	p.Purchases = append(p.Purchases, ComponentPurchase{Slot: c.Slot(), Tier: c.Tier(), Price: price})
 }
 
-func (p *Player) BuyFuelTank(ft FuelTank, cost int) {
-	p.Money -= cost
-	p.FuelTank = ft
+// PaidPrice returns what was paid for the component installed in slot, false if it wasn't bought
+func (p *Player) PaidPrice(slot ComponentSlot) (int, bool) {
+	if i := p.installedPurchase(slot); i >= 0 {
+		return p.Purchases[i].Price, true
+	}
+	return 0, false
 }
 
-func (p *Player) BuyCargoHold(ch CargoHold, cost int) {
-	p.Money -= cost
-	p.CargoHold = ch
+// SellComponent installs the lower-tier replacement and credits refund.
+// The replacement inherits the sold component's wear, so selling back never skips a repair.
+// Fails if the ore carried would not fit the replacement's cargo capacity.
+func (p *Player) SellComponent(replacement Component, refund int) bool {
+	slot := replacement.Slot()
+	if slot == SlotCargoHold && p.GetTotalOreCount() > (CargoHold{replacement}).Capacity() {
+		return false
+	}
+	replacement = replacement.Worn(1 - p.Loadout[slot].Condition())
+
+	if i := p.installedPurchase(slot); i >= 0 {
+		p.Purchases = append(p.Purchases[:i], p.Purchases[i+1:]...)
+	}
+	p.Money += refund
+	p.Loadout[slot] = replacement
+
+	// A smaller hull or tank can't hold what the old one did
+	p.HP = min(p.HP, p.Hull().MaxHP())
+	p.Fuel = min(p.Fuel, p.FuelTank().Capacity())
+	return true
 }
 
-func (p *Player) BuyHeatShield(hs HeatShield, cost int) {
-	p.Money -= cost
-	p.HeatShield = hs
+// installedPurchase returns the index of the latest purchase of slot's installed tier, -1 if none
+func (p *Player) installedPurchase(slot ComponentSlot) int {
+	installed := p.Loadout[slot]
+	for i := len(p.Purchases) - 1; i >= 0; i-- {
+		if p.Purchases[i].Slot == slot && p.Purchases[i].Tier == installed.Tier() {
+			return i
+		}
+	}
+	return -1
+}
+
+// Component accessors
+
+func (p *Player) Engine() Engine {
+	return Engine{p.Loadout[SlotEngine]}
+}
+
+func (p *Player) Hull() Hull {
+	return Hull{p.Loadout[SlotHull]}
 }
 
-func (p *Player) BuyDrill(d Drill, cost int) {
+func (p *Player) FuelTank() FuelTank {
+	return FuelTank{p.Loadout[SlotFuelTank]}
+}
+
+func (p *Player) CargoHold() CargoHold {
+	return CargoHold{p.Loadout[SlotCargoHold]}
+}
+
+func (p *Player) HeatShield() HeatShield {
+	return HeatShield{p.Loadout[SlotHeatShield]}
+}
+
+func (p *Player) Drill() Drill {
+	return Drill{p.Loadout[SlotDrill]}
+}
+
+func (p *Player) OreDetector() OreDetector {
+	return OreDetector{p.Loadout[SlotOreDetector]}
+}
+
+// WearComponents reduces the condition of the components in slots by amount
+func (p *Player) WearComponents(amount float32, slots ...ComponentSlot) {
+	for _, slot := range slots {
+		if c, ok := p.Loadout[slot]; ok {
+			p.Loadout[slot] = c.Worn(amount)
+		}
+	}
+}
+
+// RepairCost returns the price of restoring every component to pristine condition
+func (p *Player) RepairCost() int {
+	cost := 0
+	for _, c := range p.Loadout {
+		wear := float64(1 - c.Condition())
+		cost += int(math.Ceil(wear * RepairCostPerComponent * float64(c.Tier()+1)))
+	}
+	return cost
+}
+
+// Repair restores every component to pristine condition if player can afford it, returns success
+func (p *Player) Repair() bool {
+	cost := p.RepairCost()
+	if cost == 0 {
+		return true // Nothing worn
+	}
+
+	if !p.CanAfford(cost) {
+		return false
+	}
+
 	p.Money -= cost
-	p.Drill = d
+	for slot, c := range p.Loadout {
+		p.Loadout[slot] = c.Repaired()
+	}
+	return true
 }
 
 // Refuel fills the tank if player can afford it, returns success
 func (p *Player) Refuel() bool {
-	fuelCapacity := p.FuelTank.Capacity()
+	fuelCapacity := p.FuelTank().Capacity()
 	litersNeeded := fuelCapacity - p.Fuel
 	cost := int(math.Ceil(float64(litersNeeded)))
 
@@ -108,7 +194,7 @@ func (p *Player) Refuel() bool {
 
 // Heal restores HP to max if player can afford it, returns success
 func (p *Player) Heal() bool {
-	maxHP := p.Hull.MaxHP()
+	maxHP := p.Hull().MaxHP()
 	hpNeeded := maxHP - p.HP
 
 	if hpNeeded <= 0 {
@@ -127,7 +213,20 @@ func (p *Player) Heal() bool {
 }
 
 // DealDamage applies damage to player HP, clamping at zero
+// An active shield absorbs damage first and breaks once its capacity is spent
 func (p *Player) DealDamage(damage float32) {
+	if shield := p.Status.Get(EffectShielded); shield != nil {
+		absorbed := damage
+		if absorbed > shield.Magnitude {
+			absorbed = shield.Magnitude
+		}
+		shield.Magnitude -= absorbed
+		damage -= absorbed
+		if shield.Magnitude <= 0 {
+			p.Status.Remove(EffectShielded)
+		}
+	}
+
 	p.HP -= damage
 	if p.HP < 0 {
 		p.HP = 0
@@ -142,19 +241,58 @@ func (p *Player) GetTotalOreCount() int {
 	return total
 }
 
+// CargoMass returns the mass of the ore carried in kg
+func (p *Player) CargoMass() float32 {
+	return CalculateInventoryMass(p.OreInventory)
+}
+
+// LoadFactor returns the loaded vehicle mass relative to the empty one (1 = empty hold)
+func (p *Player) LoadFactor() float32 {
+	return (PlayerMass + p.CargoMass()) / PlayerMass
+}
+
 // AddOre increments ore count for given type if capacity allows
 // Returns true if ore was added, false if cargo is full
 func (p *Player) AddOre(oreType OreType) bool {
 	if oreType < 0 || oreType >= 6 {
 		return false
 	}
-	if p.GetTotalOreCount() >= p.CargoHold.Capacity() {
+	if p.GetTotalOreCount() >= p.CargoHold().Capacity() {
 		return false // Cargo full
 	}
 	p.OreInventory[oreType]++
 	return true
 }
 
+// RemoveOre decrements ore count for given type, returns false if none is held
+func (p *Player) RemoveOre(oreType OreType) bool {
+	if oreType < 0 || oreType >= 6 {
+		return false
+	}
+	if p.OreInventory[oreType] <= 0 {
+		return false
+	}
+	p.OreInventory[oreType]--
+	return true
+}
+
+// LeastValuableOre returns the cheapest ore type currently held
+// Returns false if the hold is empty
+func (p *Player) LeastValuableOre() (OreType, bool) {
+	found := false
+	var cheapest OreType
+	for _, oreType := range GetAllOreTypes() {
+		if p.OreInventory[oreType] == 0 {
+			continue
+		}
+		if !found || OreValues[oreType] < OreValues[cheapest] {
+			cheapest = oreType
+			found = true
+		}
+	}
+	return cheapest, found
+}
+
 // SellInventory sells all ore in inventory and adds value to player's money
 func (p *Player) SellInventory() {
 	totalValue := CalculateInventoryValue(p.OreInventory)
@@ -162,23 +300,61 @@ func (p *Player) SellInventory() {
 	p.OreInventory = [6]int{}
 }
 
-// AddItem increments item count for given type
-func (p *Player) AddItem(itemType ItemType) bool {
-	if itemType < 0 || itemType >= 5 {
+// AddItem increments the count for the given item, returns false at its stack limit
+func (p *Player) AddItem(item ItemDefinition) bool {
+	if p.ItemInventory[item.ID] >= item.StackLimit {
 		return false
 	}
-	p.ItemInventory[itemType]++
+	p.ItemInventory[item.ID]++
 	return true
 }
 
 // UseItem decrements item count if available, returns success
-func (p *Player) UseItem(itemType ItemType) bool {
-	if itemType < 0 || itemType >= 5 {
+func (p *Player) UseItem(id ItemID) bool {
+	if p.ItemInventory[id] <= 0 {
 		return false
 	}
-	if p.ItemInventory[itemType] <= 0 {
-		return false
-	}
-	p.ItemInventory[itemType]--
+	p.ItemInventory[id]--
 	return true
 }
+
+// TickStatusEffects runs tick callbacks, counts effects down and expires those that wore off
+// Callbacks may change the player's statuses (burning can break a shield), so each kind is looked up afresh
+func (p *Player) TickStatusEffects(dt float32) {
+	kinds := make([]EffectKind, 0, len(p.Status.active))
+	for _, effect := range p.Status.active {
+		kinds = append(kinds, effect.Kind)
+	}
+
+	for _, kind := range kinds {
+		def := StatusEffectDefinitions[kind]
+		if effect := p.Status.Get(kind); effect != nil && def.OnTick != nil {
+			def.OnTick(p, effect, dt)
+		}
+
+		effect := p.Status.Get(kind)
+		if effect == nil {
+			continue
+		}
+		effect.Remaining -= dt
+		if effect.Remaining > 0 {
+			continue
+		}
+
+		expired := *effect
+		p.Status.Remove(kind)
+		if def.OnExpire != nil {
+			def.OnExpire(p, expired)
+		}
+	}
+}
+
+// DrillSpeed returns the drill's speed with status modifiers (drill boost) applied
+func (p *Player) DrillSpeed() float32 {
+	return p.Drill().DrillSpeed() * p.Status.Modifiers().DrillSpeedMultiplier
+}
+
+// HeatResistance returns the heat shield's resistance plus status bonuses (coolant)
+func (p *Player) HeatResistance() float32 {
+	return p.HeatShield().HeatResistance() + p.Status.Modifiers().HeatResistanceBonus
+}
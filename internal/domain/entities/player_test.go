@@ -200,3 +200,105 @@ func TestPlayer_DealDamage_ZeroDamage(t *testing.T) {
 		t.Errorf("Expected no change with zero damage, got HP %f", player.HP)
 	}
 }
+
+func TestPlayer_RemoveOre(t *testing.T) {
+	player := NewPlayer(0, 0)
+	player.AddOre(OreGold)
+
+	if !player.RemoveOre(OreGold) {
+		t.Error("RemoveOre should succeed for held ore")
+	}
+	if player.RemoveOre(OreGold) {
+		t.Error("RemoveOre should fail once none is left")
+	}
+	if player.RemoveOre(OreType(999)) {
+		t.Error("RemoveOre should return false for invalid ore type")
+	}
+}
+
+func TestPlayer_LeastValuableOre(t *testing.T) {
+	player := NewPlayer(0, 0)
+
+	if _, ok := player.LeastValuableOre(); ok {
+		t.Error("Empty hold should have no least valuable ore")
+	}
+
+	player.AddOre(OreDiamond)
+	player.AddOre(OreIron)
+	player.AddOre(OreGold)
+
+	if oreType, ok := player.LeastValuableOre(); !ok || oreType != OreIron {
+		t.Errorf("Expected Iron as least valuable ore, got %v (ok=%v)", oreType, ok)
+	}
+}
+
+func TestPlayer_LoadFactorFollowsOreMass(t *testing.T) {
+	player := NewPlayer(0, 0)
+	if player.LoadFactor() != 1 {
+		t.Errorf("Empty hold should have load factor 1, got %f", player.LoadFactor())
+	}
+
+	player.OreInventory[OreDiamond] = 2
+	player.OreInventory[OrePlatinum] = 3
+	expectedMass := 2*OreMass[OreDiamond] + 3*OreMass[OrePlatinum]
+
+	if player.CargoMass() != expectedMass {
+		t.Errorf("Expected cargo mass %f, got %f", expectedMass, player.CargoMass())
+	}
+	if player.LoadFactor() != (PlayerMass+expectedMass)/PlayerMass {
+		t.Errorf("Unexpected load factor %f", player.LoadFactor())
+	}
+}
+
+func TestPlayer_WearAffectsEngineAndDrill(t *testing.T) {
+	player := NewPlayer(0, 0)
+	baseAccel := player.Engine().Acceleration()
+	baseDrill := player.Drill().DrillSpeed()
+
+	player.WearComponents(1, SlotEngine, SlotDrill)
+
+	if player.Engine().Acceleration() != baseAccel*MalfunctionFloor {
+		t.Errorf("Expected broken engine acceleration %f, got %f", baseAccel*MalfunctionFloor, player.Engine().Acceleration())
+	}
+	if player.Drill().DrillSpeed() != baseDrill*MalfunctionFloor {
+		t.Errorf("Expected broken drill speed %f, got %f", baseDrill*MalfunctionFloor, player.Drill().DrillSpeed())
+	}
+	if player.Hull().Condition() != 1 {
+		t.Error("Unlisted slots should not wear")
+	}
+}
+
+func TestPlayer_RepairChargesByWearAndTier(t *testing.T) {
+	player := NewPlayer(0, 0)
+	if player.RepairCost() != 0 {
+		t.Fatalf("Pristine loadout should cost nothing, got %d", player.RepairCost())
+	}
+
+	player.Loadout[SlotEngine] = NewComponent(SlotEngine, 2).Worn(0.5)
+	player.WearComponents(0.25, SlotDrill)
+	expected := 150 + 25 // Half-worn Mk2 (x3) + quarter-worn base drill (x1)
+
+	if player.RepairCost() != expected {
+		t.Fatalf("Expected repair cost %d, got %d", expected, player.RepairCost())
+	}
+	money := player.Money
+	if !player.Repair() {
+		t.Fatal("Repair should succeed")
+	}
+	if player.Money != money-expected || player.Engine().Condition() != 1 || player.Drill().Condition() != 1 {
+		t.Errorf("Expected everything repaired for %d, money went %d -> %d", expected, money, player.Money)
+	}
+}
+
+func TestPlayer_RepairRequiresMoney(t *testing.T) {
+	player := NewPlayer(0, 0)
+	player.WearComponents(1, SlotEngine)
+	player.Money = 10
+
+	if player.Repair() {
+		t.Error("Repair should fail without enough money")
+	}
+	if player.Money != 10 || player.Engine().Condition() != 0 {
+		t.Error("Failed repair should change nothing")
+	}
+}
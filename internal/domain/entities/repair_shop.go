@@ -0,0 +1,24 @@
+package entities
+
+import "github.com/Kishlin/drill-game/internal/domain/types"
+
+const (
+	RepairShopWidth  = 320.0 // 5 tiles * 64px
+	RepairShopHeight = 192.0 // 3 tiles * 64px
+
+	RepairCostPerComponent = 100.0 // $ to fully restore a broken base component; scales with tier+1
+)
+
+type RepairShop struct {
+	AABB types.AABB
+}
+
+func NewRepairShop(x, y float32) *RepairShop {
+	return &RepairShop{
+		AABB: types.NewAABB(x, y, RepairShopWidth, RepairShopHeight),
+	}
+}
+
+func (rs *RepairShop) IsPlayerInRange(player *Player) bool {
+	return rs.AABB.Intersects(player.AABB)
+}
@@ -0,0 +1,219 @@
+package entities
+
+// EffectKind identifies a status effect on the player
+type EffectKind int
+
+const (
+	EffectParachute  EffectKind = iota // Caps fall speed (no fall damage)
+	EffectShielded                     // Absorbs incoming damage; Magnitude is the HP left to absorb
+	EffectBoosted                      // Magnitude multiplies Drill.DrillSpeed
+	EffectCoolant                      // Magnitude adds °C on top of HeatShield.HeatResistance
+	EffectFlare                        // Magnitude adds tiles to the sight radius
+	EffectBurning                      // Magnitude is damage per second
+	EffectOverheated                   // Engine strains: slower movement, more fuel burned
+	EffectStunned                      // No movement or drilling input
+)
+
+// StackRule decides what applying an effect does when one of the same kind is already active
+type StackRule int
+
+const (
+	StackRefresh   StackRule = iota // Restart the duration with the new magnitude
+	StackExtend                     // Add the new duration, keep the stronger magnitude
+	StackIntensify                  // Add the magnitudes (up to MaxMagnitude) and restart the duration
+	StackIgnore                     // Keep the running effect untouched
+)
+
+const (
+	OverheatedSpeedMultiplier = 0.75 // Engine max speed while overheated
+	OverheatedFuelMultiplier  = 1.5  // Fuel consumption while overheated
+)
+
+// StatusModifiers is the combined effect of every active status on the player's stats
+// Systems query it instead of reading component getters directly
+type StatusModifiers struct {
+	SpeedMultiplier      float32 // Engine max speed and acceleration
+	DrillSpeedMultiplier float32 // Drill.DrillSpeed
+	FuelMultiplier       float32 // Fuel consumption rate
+	HeatResistanceBonus  float32 // °C added to HeatShield.HeatResistance
+	SightBonus           float32 // Tiles added to the sight radius
+	FallSpeedCapped      bool    // Fall speed limited to physics.ParachuteFallSpeed
+	Stunned              bool    // Movement and drilling input ignored
+}
+
+// NeutralModifiers returns modifiers that leave every stat unchanged
+func NeutralModifiers() StatusModifiers {
+	return StatusModifiers{
+		SpeedMultiplier:      1,
+		DrillSpeedMultiplier: 1,
+		FuelMultiplier:       1,
+	}
+}
+
+// StatusEffectDefinition describes how one kind of status behaves
+type StatusEffectDefinition struct {
+	Name         string
+	Debuff       bool // Harmful status (shown in red on the HUD)
+	Stacking     StackRule
+	MaxMagnitude float32                                                // Cap for StackIntensify (0 = no cap)
+	Modify       func(effect StatusEffect, mods *StatusModifiers)       // Folds the effect into the modifiers (optional)
+	OnTick       func(player *Player, effect *StatusEffect, dt float32) // Runs every frame while active (optional)
+	OnExpire     func(player *Player, effect StatusEffect)              // Runs once when the duration runs out (optional)
+}
+
+// StatusEffectDefinitions provides the behaviour of each effect kind
+var StatusEffectDefinitions = map[EffectKind]StatusEffectDefinition{
+	EffectParachute: {
+		Name: "Parachute",
+		Modify: func(_ StatusEffect, mods *StatusModifiers) {
+			mods.FallSpeedCapped = true
+		},
+	},
+	EffectShielded: {
+		Name: "Shielded", // Absorption happens in Player.DealDamage
+	},
+	EffectBoosted: {
+		Name: "Boosted",
+		Modify: func(effect StatusEffect, mods *StatusModifiers) {
+			mods.DrillSpeedMultiplier *= effect.Magnitude
+		},
+	},
+	EffectCoolant: {
+		Name: "Coolant",
+		Modify: func(effect StatusEffect, mods *StatusModifiers) {
+			mods.HeatResistanceBonus += effect.Magnitude
+		},
+	},
+	EffectFlare: {
+		Name: "Flare",
+		Modify: func(effect StatusEffect, mods *StatusModifiers) {
+			mods.SightBonus += effect.Magnitude
+		},
+	},
+	EffectBurning: {
+		Name:         "Burning",
+		Debuff:       true,
+		Stacking:     StackIntensify,
+		MaxMagnitude: 3,
+		OnTick: func(player *Player, effect *StatusEffect, dt float32) {
+			player.DealDamage(effect.Magnitude * dt)
+		},
+	},
+	EffectOverheated: {
+		Name:   "Overheated",
+		Debuff: true,
+		Modify: func(_ StatusEffect, mods *StatusModifiers) {
+			mods.SpeedMultiplier *= OverheatedSpeedMultiplier
+			mods.FuelMultiplier *= OverheatedFuelMultiplier
+		},
+	},
+	EffectStunned: {
+		Name:     "Stunned",
+		Debuff:   true,
+		Stacking: StackIgnore, // A new stun can't chain-lock the player
+		Modify: func(_ StatusEffect, mods *StatusModifiers) {
+			mods.Stunned = true
+		},
+	},
+}
+
+// StatusEffect is a status that wears off after Duration seconds
+type StatusEffect struct {
+	Kind      EffectKind
+	Duration  float32 // Total seconds, for HUD progress
+	Remaining float32 // Seconds left
+	Magnitude float32 // Strength; meaning depends on Kind
+}
+
+func NewStatusEffect(kind EffectKind, duration, magnitude float32) StatusEffect {
+	return StatusEffect{
+		Kind:      kind,
+		Duration:  duration,
+		Remaining: duration,
+		Magnitude: magnitude,
+	}
+}
+
+// StatusEffects is the player's set of active statuses, at most one per kind
+type StatusEffects struct {
+	active []StatusEffect
+}
+
+// Apply starts an effect, combining it with a running one of the same kind by its StackRule
+func (s *StatusEffects) Apply(effect StatusEffect) {
+	existing := s.Get(effect.Kind)
+	if existing == nil {
+		s.active = append(s.active, effect)
+		return
+	}
+
+	def := StatusEffectDefinitions[effect.Kind]
+	switch def.Stacking {
+	case StackExtend:
+		existing.Remaining += effect.Remaining
+		existing.Duration = existing.Remaining
+		if effect.Magnitude > existing.Magnitude {
+			existing.Magnitude = effect.Magnitude
+		}
+	case StackIntensify:
+		magnitude := existing.Magnitude + effect.Magnitude
+		if def.MaxMagnitude > 0 && magnitude > def.MaxMagnitude {
+			magnitude = def.MaxMagnitude
+		}
+		*existing = effect
+		existing.Magnitude = magnitude
+	case StackIgnore:
+		return
+	default:
+		*existing = effect
+	}
+}
+
+// Remove ends the effect of the given kind without running its expiry callback
+func (s *StatusEffects) Remove(kind EffectKind) {
+	for i := range s.active {
+		if s.active[i].Kind == kind {
+			s.active = append(s.active[:i], s.active[i+1:]...)
+			return
+		}
+	}
+}
+
+// Get returns the active effect of the given kind, or nil
+func (s *StatusEffects) Get(kind EffectKind) *StatusEffect {
+	for i := range s.active {
+		if s.active[i].Kind == kind {
+			return &s.active[i]
+		}
+	}
+	return nil
+}
+
+// Has reports whether an effect of the given kind is active
+func (s *StatusEffects) Has(kind EffectKind) bool {
+	return s.Get(kind) != nil
+}
+
+// Magnitude returns the magnitude of the active effect of the given kind, or 0
+func (s *StatusEffects) Magnitude(kind EffectKind) float32 {
+	if effect := s.Get(kind); effect != nil {
+		return effect.Magnitude
+	}
+	return 0
+}
+
+// Active returns every running effect in the order they were applied
+func (s *StatusEffects) Active() []StatusEffect {
+	return s.active
+}
+
+// Modifiers folds every active effect into one set of stat modifiers
+func (s *StatusEffects) Modifiers() StatusModifiers {
+	mods := NeutralModifiers()
+	for _, effect := range s.active {
+		if modify := StatusEffectDefinitions[effect.Kind].Modify; modify != nil {
+			modify(effect, &mods)
+		}
+	}
+	return mods
+}
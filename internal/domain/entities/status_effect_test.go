@@ -0,0 +1,117 @@
+package entities
+
+import "testing"
+
+func TestStatusEffects_RefreshRestartsDuration(t *testing.T) {
+	player := NewPlayer(0, 0)
+
+	player.Status.Apply(NewStatusEffect(EffectCoolant, 10, 60))
+	player.TickStatusEffects(4)
+	player.Status.Apply(NewStatusEffect(EffectCoolant, 10, 60))
+
+	if len(player.Status.Active()) != 1 {
+		t.Fatalf("Same kind should replace, got %d effects", len(player.Status.Active()))
+	}
+	if player.Status.Get(EffectCoolant).Remaining != 10 {
+		t.Errorf("Reapplying should refresh the duration, got %f", player.Status.Get(EffectCoolant).Remaining)
+	}
+}
+
+func TestStatusEffects_IntensifyAddsUpToCap(t *testing.T) {
+	var status StatusEffects
+	maxBurn := StatusEffectDefinitions[EffectBurning].MaxMagnitude
+
+	status.Apply(NewStatusEffect(EffectBurning, 3, 1))
+	status.Apply(NewStatusEffect(EffectBurning, 3, 1))
+	if status.Magnitude(EffectBurning) != 2 {
+		t.Errorf("Burning should stack to 2 DPS, got %f", status.Magnitude(EffectBurning))
+	}
+
+	status.Apply(NewStatusEffect(EffectBurning, 3, maxBurn))
+	if status.Magnitude(EffectBurning) != maxBurn {
+		t.Errorf("Burning should cap at %f DPS, got %f", maxBurn, status.Magnitude(EffectBurning))
+	}
+}
+
+func TestStatusEffects_IgnoreKeepsRunningStun(t *testing.T) {
+	var status StatusEffects
+
+	status.Apply(NewStatusEffect(EffectStunned, 0.5, 0))
+	status.Apply(NewStatusEffect(EffectStunned, 5, 0))
+
+	if status.Get(EffectStunned).Remaining != 0.5 {
+		t.Errorf("A second stun should not extend the first, got %f", status.Get(EffectStunned).Remaining)
+	}
+}
+
+func TestPlayer_TickStatusEffects_RemovesExpired(t *testing.T) {
+	player := NewPlayer(0, 0)
+	player.Status.Apply(NewStatusEffect(EffectFlare, 1, 5))
+	player.Status.Apply(NewStatusEffect(EffectParachute, 3, 0))
+
+	player.TickStatusEffects(1.5)
+
+	if player.Status.Has(EffectFlare) {
+		t.Error("Flare should have worn off")
+	}
+	if !player.Status.Has(EffectParachute) {
+		t.Error("Parachute should still be active")
+	}
+	if player.Status.Modifiers().SightBonus != 0 {
+		t.Error("Expired effect should no longer modify stats")
+	}
+}
+
+func TestPlayer_TickStatusEffects_BurningDealsDamage(t *testing.T) {
+	player := NewPlayer(0, 0)
+	player.Status.Apply(NewStatusEffect(EffectBurning, 3, 2))
+
+	player.TickStatusEffects(0.5)
+
+	if player.HP != player.Hull().MaxHP()-1 {
+		t.Errorf("2 DPS for 0.5s should deal 1 damage, HP %f", player.HP)
+	}
+}
+
+func TestPlayer_ShieldAbsorbsDamageThenBreaks(t *testing.T) {
+	player := NewPlayer(0, 0)
+	player.Status.Apply(NewStatusEffect(EffectShielded, 60, 4))
+
+	player.DealDamage(3)
+	if player.HP != player.Hull().MaxHP() {
+		t.Errorf("Shield should absorb all 3 damage, HP %f", player.HP)
+	}
+
+	player.DealDamage(3)
+	if player.HP != player.Hull().MaxHP()-2 {
+		t.Errorf("Shield had 1 HP left, expected 2 damage through, HP %f", player.HP)
+	}
+	if player.Status.Has(EffectShielded) {
+		t.Error("Spent shield should break")
+	}
+}
+
+func TestStatusEffects_ModifiersCombine(t *testing.T) {
+	player := NewPlayer(0, 0)
+	baseSpeed := player.DrillSpeed()
+	baseResistance := player.HeatResistance()
+
+	player.Status.Apply(NewStatusEffect(EffectBoosted, 30, DrillBoostMultiplier))
+	player.Status.Apply(NewStatusEffect(EffectCoolant, 45, CoolantResistance))
+	player.Status.Apply(NewStatusEffect(EffectOverheated, 1, 0))
+
+	if player.DrillSpeed() != baseSpeed*DrillBoostMultiplier {
+		t.Errorf("Expected boosted drill speed %f, got %f", baseSpeed*DrillBoostMultiplier, player.DrillSpeed())
+	}
+	if player.HeatResistance() != baseResistance+CoolantResistance {
+		t.Errorf("Expected resistance %f, got %f", baseResistance+CoolantResistance, player.HeatResistance())
+	}
+
+	mods := player.Status.Modifiers()
+	if mods.SpeedMultiplier != OverheatedSpeedMultiplier || mods.FuelMultiplier != OverheatedFuelMultiplier {
+		t.Errorf("Overheated should slow the engine and burn more fuel, got %+v", mods)
+	}
+	if mods.Stunned || mods.FallSpeedCapped {
+		t.Errorf("Unrelated modifiers should stay neutral, got %+v", mods)
+	}
+}
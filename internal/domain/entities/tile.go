@@ -8,8 +8,8 @@ type TileType int
 
 const (
 	TileTypeEmpty TileType = iota // Air/empty space
-	TileTypeDirt                   // Solid dirt (drillable)
-	TileTypeOre                    // Solid ore (drillable, contains ore)
+	TileTypeDirt                  // Solid dirt (drillable)
+	TileTypeOre                   // Solid ore (drillable, contains ore)
 )
 
 type Tile struct {
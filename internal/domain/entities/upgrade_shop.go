@@ -5,238 +5,77 @@ import "github.com/Kishlin/drill-game/internal/domain/types"
 const (
 	UpgradeShopWidth  = 320.0
 	UpgradeShopHeight = 192.0
-)
 
-// Engine Upgrade Shop
+	TradeInRate = 0.5 // Share of the installed component's value credited when upgrading
+	ResaleRate  = 0.3 // Share of the installed component's value refunded when selling it back
+)
 
-type EngineCatalogEntry struct {
-	Price  int
-	Engine Engine
+type CatalogEntry struct {
+	Price     int
+	Component Component
 }
 
-type EngineUpgradeShop struct {
+// UpgradeShop sells the tiers of one component line above the base tier
+type UpgradeShop struct {
 	AABB    types.AABB
-	Catalog []EngineCatalogEntry
+	Slot    ComponentSlot
+	Label   string
+	Base    Component // Installed after selling back tier 1
+	Catalog []CatalogEntry
 }
 
-func NewEngineUpgradeShop(x, y float32) *EngineUpgradeShop {
-	return &EngineUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []EngineCatalogEntry{
-			{Price: 100, Engine: NewEngineMk1()},
-			{Price: 300, Engine: NewEngineMk2()},
-			{Price: 750, Engine: NewEngineMk3()},
-			{Price: 1500, Engine: NewEngineMk4()},
-			{Price: 5000, Engine: NewEngineMk5()},
-		},
+func NewUpgradeShop(line ComponentLine, x, y float32) *UpgradeShop {
+	catalog := make([]CatalogEntry, 0, line.MaxTier())
+	for tier := 1; tier <= line.MaxTier(); tier++ {
+		catalog = append(catalog, CatalogEntry{Price: line.Tiers[tier].Price, Component: line.Component(tier)})
 	}
-}
 
-func (s *EngineUpgradeShop) IsPlayerInRange(player *Player) bool {
-	return s.AABB.Intersects(player.AABB)
-}
-
-func (s *EngineUpgradeShop) GetNextEngine(currentTier int) *EngineCatalogEntry {
-	nextTier := currentTier + 1
-	for i := range s.Catalog {
-		if s.Catalog[i].Engine.Tier() == nextTier {
-			return &s.Catalog[i]
-		}
+	return &UpgradeShop{
+		AABB:    types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
+		Slot:    line.Slot,
+		Label:   line.Label,
+		Base:    line.Component(0),
+		Catalog: catalog,
 	}
-	return nil // Max level reached
 }
 
-// Hull Upgrade Shop
-
-type HullCatalogEntry struct {
-	Price int
-	Hull  Hull
-}
-
-type HullUpgradeShop struct {
-	AABB    types.AABB
-	Catalog []HullCatalogEntry
-}
-
-func NewHullUpgradeShop(x, y float32) *HullUpgradeShop {
-	return &HullUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []HullCatalogEntry{
-			{Price: 150, Hull: NewHullMk1()},
-			{Price: 400, Hull: NewHullMk2()},
-			{Price: 1000, Hull: NewHullMk3()},
-			{Price: 2500, Hull: NewHullMk4()},
-			{Price: 8000, Hull: NewHullMk5()},
-		},
-	}
-}
-
-func (s *HullUpgradeShop) IsPlayerInRange(player *Player) bool {
+func (s *UpgradeShop) IsPlayerInRange(player *Player) bool {
 	return s.AABB.Intersects(player.AABB)
 }
 
-func (s *HullUpgradeShop) GetNextHull(currentTier int) *HullCatalogEntry {
-	nextTier := currentTier + 1
-	for i := range s.Catalog {
-		if s.Catalog[i].Hull.Tier() == nextTier {
-			return &s.Catalog[i]
-		}
-	}
-	return nil // Max level reached
-}
-
-// FuelTank Upgrade Shop
-
-type FuelTankCatalogEntry struct {
-	Price    int
-	FuelTank FuelTank
-}
-
-type FuelTankUpgradeShop struct {
-	AABB    types.AABB
-	Catalog []FuelTankCatalogEntry
-}
-
-func NewFuelTankUpgradeShop(x, y float32) *FuelTankUpgradeShop {
-	return &FuelTankUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []FuelTankCatalogEntry{
-			{Price: 100, FuelTank: NewFuelTankMk1()},
-			{Price: 250, FuelTank: NewFuelTankMk2()},
-			{Price: 600, FuelTank: NewFuelTankMk3()},
-			{Price: 1500, FuelTank: NewFuelTankMk4()},
-			{Price: 4000, FuelTank: NewFuelTankMk5()},
-		},
-	}
-}
-
-func (s *FuelTankUpgradeShop) IsPlayerInRange(player *Player) bool {
-	return s.AABB.Intersects(player.AABB)
-}
-
-func (s *FuelTankUpgradeShop) GetNextFuelTank(currentTier int) *FuelTankCatalogEntry {
-	nextTier := currentTier + 1
-	for i := range s.Catalog {
-		if s.Catalog[i].FuelTank.Tier() == nextTier {
-			return &s.Catalog[i]
-		}
-	}
-	return nil // Max level reached
-}
-
-// CargoHold Upgrade Shop
-
-type CargoHoldCatalogEntry struct {
-	Price     int
-	CargoHold CargoHold
+func (s *UpgradeShop) GetNext(currentTier int) *CatalogEntry {
+	return s.GetEntry(currentTier + 1) // nil once max level is reached
 }
 
-type CargoHoldUpgradeShop struct {
-	AABB    types.AABB
-	Catalog []CargoHoldCatalogEntry
-}
-
-func NewCargoHoldUpgradeShop(x, y float32) *CargoHoldUpgradeShop {
-	return &CargoHoldUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []CargoHoldCatalogEntry{
-			{Price: 125, CargoHold: NewCargoHoldMk1()},
-			{Price: 350, CargoHold: NewCargoHoldMk2()},
-			{Price: 800, CargoHold: NewCargoHoldMk3()},
-			{Price: 2000, CargoHold: NewCargoHoldMk4()},
-			{Price: 6000, CargoHold: NewCargoHoldMk5()},
-		},
-	}
-}
-
-func (s *CargoHoldUpgradeShop) IsPlayerInRange(player *Player) bool {
-	return s.AABB.Intersects(player.AABB)
-}
-
-func (s *CargoHoldUpgradeShop) GetNextCargoHold(currentTier int) *CargoHoldCatalogEntry {
-	nextTier := currentTier + 1
+// GetEntry returns the catalog entry for tier (nil for the base tier, which isn't sold)
+func (s *UpgradeShop) GetEntry(tier int) *CatalogEntry {
 	for i := range s.Catalog {
-		if s.Catalog[i].CargoHold.Tier() == nextTier {
+		if s.Catalog[i].Component.Tier() == tier {
 			return &s.Catalog[i]
 		}
 	}
-	return nil // Max level reached
-}
-
-// HeatShield Upgrade Shop
-
-type HeatShieldCatalogEntry struct {
-	Price      int
-	HeatShield HeatShield
+	return nil
 }
 
-type HeatShieldUpgradeShop struct {
-	AABB    types.AABB
-	Catalog []HeatShieldCatalogEntry
-}
-
-func NewHeatShieldUpgradeShop(x, y float32) *HeatShieldUpgradeShop {
-	return &HeatShieldUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []HeatShieldCatalogEntry{
-			{Price: 200, HeatShield: NewHeatShieldMk1()},
-			{Price: 500, HeatShield: NewHeatShieldMk2()},
-			{Price: 1200, HeatShield: NewHeatShieldMk3()},
-			{Price: 3000, HeatShield: NewHeatShieldMk4()},
-			{Price: 7500, HeatShield: NewHeatShieldMk5()},
-		},
+// ValueOf returns the catalog price of tier (0 for the base tier)
+func (s *UpgradeShop) ValueOf(tier int) int {
+	if entry := s.GetEntry(tier); entry != nil {
+		return entry.Price
 	}
+	return 0
 }
 
-func (s *HeatShieldUpgradeShop) IsPlayerInRange(player *Player) bool {
-	return s.AABB.Intersects(player.AABB)
-}
-
-func (s *HeatShieldUpgradeShop) GetNextHeatShield(currentTier int) *HeatShieldCatalogEntry {
-	nextTier := currentTier + 1
-	for i := range s.Catalog {
-		if s.Catalog[i].HeatShield.Tier() == nextTier {
-			return &s.Catalog[i]
-		}
+// GetPrevious returns the component one tier below currentTier, false if already at base
+func (s *UpgradeShop) GetPrevious(currentTier int) (Component, bool) {
+	if currentTier <= s.Base.Tier() {
+		return Component{}, false
 	}
-	return nil // Max level reached
-}
-
-// Drill Upgrade Shop
-
-type DrillCatalogEntry struct {
-	Price int
-	Drill Drill
-}
-
-type DrillUpgradeShop struct {
-	AABB    types.AABB
-	Catalog []DrillCatalogEntry
-}
-
-func NewDrillUpgradeShop(x, y float32) *DrillUpgradeShop {
-	return &DrillUpgradeShop{
-		AABB: types.NewAABB(x, y, UpgradeShopWidth, UpgradeShopHeight),
-		Catalog: []DrillCatalogEntry{
-			{Price: 125, Drill: NewDrillMk1()},
-			{Price: 350, Drill: NewDrillMk2()},
-			{Price: 875, Drill: NewDrillMk3()},
-			{Price: 2000, Drill: NewDrillMk4()},
-			{Price: 6500, Drill: NewDrillMk5()},
-		},
+	if currentTier-1 == s.Base.Tier() {
+		return s.Base, true
 	}
-}
-
-func (s *DrillUpgradeShop) IsPlayerInRange(player *Player) bool {
-	return s.AABB.Intersects(player.AABB)
-}
-
-func (s *DrillUpgradeShop) GetNextDrill(currentTier int) *DrillCatalogEntry {
-	nextTier := currentTier + 1
-	for i := range s.Catalog {
-		if s.Catalog[i].Drill.Tier() == nextTier {
-			return &s.Catalog[i]
-		}
+	entry := s.GetEntry(currentTier - 1)
+	if entry == nil {
+		return Component{}, false
 	}
-	return nil // Max level reached
+	return entry.Component, true
 }
@@ -7,11 +7,18 @@ type InputState struct {
 	Up          bool
 	Drill       bool // Down for drilling
 	Sell        bool // E key for selling at market
-	UseTeleport bool // T key for teleport item
-	UseRepair   bool // R key for repair item
-	UseRefuel   bool // F key for refuel item
-	UseBomb     bool // B key for bomb item
-	UseBigBomb  bool // G key for big bomb item
+	ToggleMap   bool // M key for the explored-terrain map screen
+	Scan        bool // Q key for an ore detector scan
+	CancelDrill bool // X key to stop the current drill (progress is kept)
+	SellBack    bool // R key to sell the installed component back at its upgrade shop
+
+	CycleCargoPolicy bool // C key to switch what happens to ore when the hold is full
+	KeepNewOre       bool // Y key: keep the prompted ore, dropping the least valuable
+	DiscardNewOre    bool // N key: leave the prompted ore behind
+	JettisonOre      int  // 1-6 keys: OreType+1 to throw one unit out (0 = none)
+
+	CycleItem       int  // [ and ] keys: -1 or +1 to move the hotbar selection (0 = none)
+	UseSelectedItem bool // Space key to use the hotbar's selected item
 }
 
 func NewInputState() InputState {
@@ -21,11 +28,18 @@ func NewInputState() InputState {
 		Up:          false,
 		Drill:       false,
 		Sell:        false,
-		UseTeleport: false,
-		UseRepair:   false,
-		UseRefuel:   false,
-		UseBomb:     false,
-		UseBigBomb:  false,
+		ToggleMap:   false,
+		Scan:        false,
+		CancelDrill: false,
+		SellBack:    false,
+
+		CycleCargoPolicy: false,
+		KeepNewOre:       false,
+		DiscardNewOre:    false,
+		JettisonOre:      0,
+
+		CycleItem:       0,
+		UseSelectedItem: false,
 	}
 }
 
@@ -42,3 +56,53 @@ func (is InputState) HasVerticalInput() bool {
 func (is InputState) HasMovementInput() bool {
 	return is.Left || is.Right || is.Up || is.Drill
 }
+
+// Held returns only the keys that are held down (movement and drilling)
+// One-shot presses are dropped so a frame split into several fixed ticks applies them once
+func (is InputState) Held() InputState {
+	return InputState{
+		Left:  is.Left,
+		Right: is.Right,
+		Up:    is.Up,
+		Drill: is.Drill,
+	}
+}
+
+// Presses returns only the one-shot presses, the counterpart of Held
+// Used to carry presses over to a later frame without also carrying keys that may since have been released
+func (is InputState) Presses() InputState {
+	presses := is
+	presses.Left, presses.Right, presses.Up, presses.Drill = false, false, false, false
+	return presses
+}
+
+// Merge returns the keys held or pressed in either state
+// Counted presses (jettison, hotbar cycling) keep is's value unless it has none
+func (is InputState) Merge(other InputState) InputState {
+	merged := InputState{
+		Left:        is.Left || other.Left,
+		Right:       is.Right || other.Right,
+		Up:          is.Up || other.Up,
+		Drill:       is.Drill || other.Drill,
+		Sell:        is.Sell || other.Sell,
+		ToggleMap:   is.ToggleMap || other.ToggleMap,
+		Scan:        is.Scan || other.Scan,
+		CancelDrill: is.CancelDrill || other.CancelDrill,
+		SellBack:    is.SellBack || other.SellBack,
+
+		CycleCargoPolicy: is.CycleCargoPolicy || other.CycleCargoPolicy,
+		KeepNewOre:       is.KeepNewOre || other.KeepNewOre,
+		DiscardNewOre:    is.DiscardNewOre || other.DiscardNewOre,
+		JettisonOre:      is.JettisonOre,
+
+		CycleItem:       is.CycleItem,
+		UseSelectedItem: is.UseSelectedItem || other.UseSelectedItem,
+	}
+	if merged.JettisonOre == 0 {
+		merged.JettisonOre = other.JettisonOre
+	}
+	if merged.CycleItem == 0 {
+		merged.CycleItem = other.CycleItem
+	}
+	return merged
+}
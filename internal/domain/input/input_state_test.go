@@ -110,3 +110,32 @@ func TestInputState_HasMovementInput_DrillAndLeftButNoOthers(t *testing.T) {
 		t.Errorf("Drill + Left: expected HasMovementInput()=%v, got %v", expected, actual)
 	}
 }
+
+func TestInputState_HeldDropsOneShotPresses(t *testing.T) {
+	inputState := InputState{Left: true, Drill: true, Sell: true, JettisonOre: 2, CycleItem: 1}
+	held := inputState.Held()
+
+	if held != (InputState{Left: true, Drill: true}) {
+		t.Errorf("expected only held keys to remain, got %+v", held)
+	}
+}
+
+func TestInputState_PressesDropsHeldKeys(t *testing.T) {
+	inputState := InputState{Left: true, Drill: true, Sell: true, JettisonOre: 2, CycleItem: 1}
+	presses := inputState.Presses()
+
+	if presses != (InputState{Sell: true, JettisonOre: 2, CycleItem: 1}) {
+		t.Errorf("expected only one-shot presses to remain, got %+v", presses)
+	}
+}
+
+func TestInputState_MergeKeepsPressesFromBoth(t *testing.T) {
+	pending := InputState{Sell: true, JettisonOre: 3}
+	current := InputState{Right: true, CycleItem: -1}
+	merged := current.Merge(pending)
+
+	expected := InputState{Right: true, Sell: true, JettisonOre: 3, CycleItem: -1}
+	if merged != expected {
+		t.Errorf("expected %+v, got %+v", expected, merged)
+	}
+}
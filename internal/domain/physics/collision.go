@@ -1,6 +1,9 @@
 package physics
 
 import (
+	"math"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/types"
 	"github.com/Kishlin/drill-game/internal/domain/world"
 )
@@ -27,25 +30,21 @@ func CheckCollisions(aabb types.AABB, w *world.World) []TileCollision {
 
 	var collisions []TileCollision
 
-	for x := minX; x <= maxX; x++ {
-		for y := minY; y <= maxY; y++ {
-			tile := w.GetTileAtGrid(x, y)
-
-			if tile == nil || !tile.IsSolid() {
-				continue
-			}
+	w.ForEachTileInRect(minX, maxX, minY, maxY, func(x, y int, tile *entities.Tile) {
+		if !tile.IsSolid() {
+			return
+		}
 
-			tileAABB := tile.GetAABB(x, y, world.TileSize)
+		tileAABB := tile.GetAABB(x, y, world.TileSize)
 
-			if aabb.Intersects(tileAABB) {
-				collisions = append(collisions, TileCollision{
-					GridX:    x,
-					GridY:    y,
-					TileAABB: tileAABB,
-				})
-			}
+		if aabb.Intersects(tileAABB) {
+			collisions = append(collisions, TileCollision{
+				GridX:    x,
+				GridY:    y,
+				TileAABB: tileAABB,
+			})
 		}
-	}
+	})
 
 	return collisions
 }
@@ -102,3 +101,55 @@ func ResolveCollisionsY(aabb types.AABB, velocity types.Vec2, collisions []TileC
 
 	return newAABB, newVel, onGround
 }
+
+// MoveAndCollideX moves the AABB by dx, checking and resolving X-axis collisions every
+// MaxCollisionStep pixels so a fast body (or a long frame) can't skip over a tile.
+// Stops at the first blocking tile; returns the updated AABB and velocity
+func MoveAndCollideX(aabb types.AABB, velocity types.Vec2, dx float32, w *world.World) (types.AABB, types.Vec2) {
+	steps := collisionSteps(dx)
+	step := dx / float32(steps)
+
+	for i := 0; i < steps; i++ {
+		aabb.X += step
+		collisions := CheckCollisions(aabb, w)
+		if len(collisions) == 0 {
+			continue
+		}
+
+		aabb, velocity = ResolveCollisionsX(aabb, velocity, collisions)
+		if velocity.X == 0 {
+			break // Blocked: the rest of the move is cancelled
+		}
+	}
+
+	return aabb, velocity
+}
+
+// MoveAndCollideY moves the AABB by dy, checking and resolving Y-axis collisions every
+// MaxCollisionStep pixels. Stops at the first blocking tile; returns the updated AABB,
+// velocity and whether the body ended up on the ground
+func MoveAndCollideY(aabb types.AABB, velocity types.Vec2, dy float32, w *world.World) (types.AABB, types.Vec2, bool) {
+	steps := collisionSteps(dy)
+	step := dy / float32(steps)
+	onGround := false
+
+	for i := 0; i < steps; i++ {
+		aabb.Y += step
+		collisions := CheckCollisions(aabb, w)
+		if len(collisions) == 0 {
+			continue
+		}
+
+		aabb, velocity, onGround = ResolveCollisionsY(aabb, velocity, collisions)
+		if velocity.Y == 0 {
+			break // Landed or hit a ceiling: the rest of the move is cancelled
+		}
+	}
+
+	return aabb, velocity, onGround
+}
+
+// collisionSteps returns how many sub-moves keep each one within MaxCollisionStep
+func collisionSteps(distance float32) int {
+	return max(int(math.Ceil(math.Abs(float64(distance))/MaxCollisionStep)), 1)
+}
@@ -3,6 +3,7 @@ package physics_test
 import (
 	"testing"
 
+	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/physics"
 	"github.com/Kishlin/drill-game/internal/domain/types"
 	"github.com/Kishlin/drill-game/internal/domain/world"
@@ -10,11 +11,11 @@ import (
 
 func TestGetOccupiedTileRange(t *testing.T) {
 	tests := []struct {
-		name                          string
-		aabb                          types.AABB
-		tileSize                      float32
-		expectedMinX, expectedMaxX    int
-		expectedMinY, expectedMaxY    int
+		name                       string
+		aabb                       types.AABB
+		tileSize                   float32
+		expectedMinX, expectedMaxX int
+		expectedMinY, expectedMaxY int
 	}{
 		{
 			name:         "Single tile",
@@ -138,3 +139,83 @@ func TestResolveCollisionsY_NoCollisions(t *testing.T) {
 		t.Error("Expected OnGround to be false with no collisions")
 	}
 }
+
+// At dt=0.25s a fast body moves several tiles in one frame; the stepped moves must still stop at the first tile
+
+func TestMoveAndCollideY_LandsOnGroundAtLongFrame(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	aabb := types.NewAABB(100, 400, 54, 54) // Bottom at 454, ground at 640
+	velocity := types.Vec2{X: 0, Y: 1000}   // 250px in a 0.25s frame, deep enough to overshoot into the ground row
+
+	newAABB, newVel, onGround := physics.MoveAndCollideY(aabb, velocity, velocity.Y*0.25, w)
+
+	if !onGround || newVel.Y != 0 {
+		t.Errorf("Expected to land with zero Y velocity, got onGround=%v vel=%f", onGround, newVel.Y)
+	}
+	if expectedY := float32(640.0 - 54.0); newAABB.Y != expectedY {
+		t.Errorf("Expected Y=%f on top of the ground, got %f", expectedY, newAABB.Y)
+	}
+}
+
+func TestMoveAndCollideY_DoesNotTunnelThroughSingleTile(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	w.SetTile(1, 3, entities.NewTile(entities.TileTypeDirt)) // Floating tile at Y 192..256
+	w.SetTile(2, 3, entities.NewTile(entities.TileTypeDirt))
+	aabb := types.NewAABB(100, 100, 54, 54) // Bottom at 154
+	velocity := types.Vec2{X: 0, Y: 1200}   // 300px in a 0.25s frame: the whole move clears the tile
+
+	newAABB, _, onGround := physics.MoveAndCollideY(aabb, velocity, velocity.Y*0.25, w)
+
+	if !onGround {
+		t.Fatal("Expected to land on the floating tile instead of falling through it")
+	}
+	if expectedY := float32(192.0 - 54.0); newAABB.Y != expectedY {
+		t.Errorf("Expected Y=%f on top of the tile, got %f", expectedY, newAABB.Y)
+	}
+}
+
+func TestMoveAndCollideY_StopsAtCeiling(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	w.SetTile(1, 1, entities.NewTile(entities.TileTypeDirt)) // Ceiling tile at Y 64..128
+	w.SetTile(2, 1, entities.NewTile(entities.TileTypeDirt))
+	aabb := types.NewAABB(100, 400, 54, 54)
+	velocity := types.Vec2{X: 0, Y: -1200}
+
+	newAABB, newVel, onGround := physics.MoveAndCollideY(aabb, velocity, velocity.Y*0.25, w)
+
+	if onGround || newVel.Y != 0 {
+		t.Errorf("Expected a ceiling stop, got onGround=%v vel=%f", onGround, newVel.Y)
+	}
+	if newAABB.Y != 128.0 {
+		t.Errorf("Expected Y=128 just under the ceiling, got %f", newAABB.Y)
+	}
+}
+
+func TestMoveAndCollideX_DoesNotTunnelThroughWall(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	w.SetTile(4, 1, entities.NewTile(entities.TileTypeDirt)) // Wall at X 256..320
+	w.SetTile(4, 2, entities.NewTile(entities.TileTypeDirt))
+	aabb := types.NewAABB(100, 100, 54, 54) // Right edge at 154
+	velocity := types.Vec2{X: 1000, Y: 0}   // 250px in a 0.25s frame: the whole move clears the wall
+
+	newAABB, newVel := physics.MoveAndCollideX(aabb, velocity, velocity.X*0.25, w)
+
+	if newVel.X != 0 {
+		t.Errorf("Expected X velocity zeroed by the wall, got %f", newVel.X)
+	}
+	if expectedX := float32(256.0 - 54.0); newAABB.X != expectedX {
+		t.Errorf("Expected X=%f against the wall, got %f", expectedX, newAABB.X)
+	}
+}
+
+func TestMoveAndCollideX_FreeMoveKeepsFullDistance(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	aabb := types.NewAABB(100, 100, 54, 54)
+	velocity := types.Vec2{X: -400, Y: 0}
+
+	newAABB, newVel := physics.MoveAndCollideX(aabb, velocity, velocity.X*0.25, w)
+
+	if newAABB.X < -0.001 || newAABB.X > 0.001 || newVel != velocity {
+		t.Errorf("Expected to move the full 100px unimpeded, got X=%f vel=%+v", newAABB.X, newVel)
+	}
+}
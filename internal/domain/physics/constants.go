@@ -8,18 +8,33 @@ const (
 	MoveDamping = 1000.0 // Horizontal deceleration (pixels per second squared)
 	FlyDamping  = 300.0  // Vertical deceleration when fly key is released (pixels per second squared)
 
+	// Net lift (pixels per second squared) the engine keeps above gravity under any cargo load
+	MinClimbAcceleration = 150.0
+
+	// Largest distance (pixels) a body moves between collision checks
+	// Kept below the smallest body (20px bombs) so overlaps are always resolved in the right direction
+	MaxCollisionStep = 8.0
+
 	// Fall damage constants
 	FallDamageThreshold = 500.0 // Minimum downward speed (px/sec) to deal damage
 	FallDamageDivisor   = 20.0  // Damage scaling: (speed - threshold) / divisor
+	ParachuteFallSpeed  = 200.0 // Max downward speed (px/sec) under a parachute, below the damage threshold
 
-	// Heat system constants
-	GroundLevelY      = 640.0   // Ground level position (pixels)
-	MaxUndergroundY   = 64000.0 // Maximum underground depth (pixels)
-	BaseTemperature   = 15.0    // Temperature at ground level (°C)
-	MaxTemperature    = 350.0   // Temperature at max depth (°C)
+	// Wall and ceiling impact constants (landings use the fall damage constants)
+	ImpactDamageThreshold = 350.0 // Minimum speed (px/sec) into a wall or ceiling to deal damage
+	ImpactDamageDivisor   = 100.0 // Damage scaling: (speed - threshold) / divisor
+	ImpactRestitution     = 0.3   // Share of the impact speed the vehicle bounces back with
 
 	// Heat damage constants
 	HeatDamageBaseDPS  = 0.5  // Base damage per second
 	HeatDamageDivisor  = 10.0 // Scaling factor for excess heat
 	HeatDamageExponent = 1.5  // Exponential scaling factor
+	OverheatedDuration = 1.0  // Seconds the Overheated status lingers after leaving the heat
+
+	// Pressure damage constants
+	PressureDamageDivisor = 50.0 // Excess pressure (atm) that deals 1 HP per second
+
+	// Component wear per point of damage taken (condition runs from 1 to 0)
+	FallWearPerDamage = 0.04 // Engine, drill and fuel tank shaken by hard landings and impacts
+	HeatWearPerDamage = 0.05 // Engine and drill cooked by overheating
 )
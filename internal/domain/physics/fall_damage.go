@@ -4,12 +4,23 @@ import "github.com/Kishlin/drill-game/internal/domain/entities"
 
 // ApplyFallDamage calculates and applies damage based on fall velocity.
 // ySpeed is positive when falling downward (screen coordinates).
+// A loaded vehicle lands harder: damage scales with the player's LoadFactor,
+// and the hull's shock absorption soaks up part of it.
+// The impact also wears the engine, drill and fuel tank.
 func ApplyFallDamage(player *entities.Player, ySpeed float32) {
 	if ySpeed < FallDamageThreshold {
 		return
 	}
 
-	damage := (ySpeed - FallDamageThreshold) / FallDamageDivisor
+	damage := (ySpeed - FallDamageThreshold) / FallDamageDivisor * player.LoadFactor()
+
+	dealImpactDamage(player, damage)
+}
+
+// dealImpactDamage applies collision damage after hull shock absorption and wears the shaken components
+func dealImpactDamage(player *entities.Player, damage float32) {
+	damage *= 1 - player.Hull().ShockAbsorption()
 
 	player.DealDamage(damage)
+	player.WearComponents(damage*FallWearPerDamage, entities.SlotEngine, entities.SlotDrill, entities.SlotFuelTank)
 }
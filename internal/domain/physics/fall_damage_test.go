@@ -1,6 +1,7 @@
 package physics
 
 import (
+	"math"
 	"testing"
 
 	"github.com/Kishlin/drill-game/internal/domain/entities"
@@ -9,10 +10,9 @@ import (
 
 func TestApplyFallDamage_BelowThreshold(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 400 px/sec (below 500 threshold)
@@ -25,10 +25,9 @@ func TestApplyFallDamage_BelowThreshold(t *testing.T) {
 
 func TestApplyFallDamage_AtThreshold(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at exactly 500 px/sec (threshold)
@@ -41,10 +40,9 @@ func TestApplyFallDamage_AtThreshold(t *testing.T) {
 
 func TestApplyFallDamage_SlightlyAboveThreshold(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 520 px/sec: damage = (520 - 500) / 20 = 1.0
@@ -57,10 +55,9 @@ func TestApplyFallDamage_SlightlyAboveThreshold(t *testing.T) {
 
 func TestApplyFallDamage_ModerateFall(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 600 px/sec: damage = (600 - 500) / 20 = 5.0
@@ -73,10 +70,9 @@ func TestApplyFallDamage_ModerateFall(t *testing.T) {
 
 func TestApplyFallDamage_LethalFall(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 700 px/sec: damage = (700 - 500) / 20 = 10.0 (lethal)
@@ -89,10 +85,9 @@ func TestApplyFallDamage_LethalFall(t *testing.T) {
 
 func TestApplyFallDamage_ExtremeVelocity(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 1500 px/sec: damage = (1500 - 500) / 20 = 50.0
@@ -106,10 +101,9 @@ func TestApplyFallDamage_ExtremeVelocity(t *testing.T) {
 
 func TestApplyFallDamage_PreservesPartialHealth(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     8.0, // Damaged player
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      8.0, // Damaged player
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 600 px/sec: damage = (600 - 500) / 20 = 5.0
@@ -123,10 +117,9 @@ func TestApplyFallDamage_PreservesPartialHealth(t *testing.T) {
 
 func TestApplyFallDamage_AlreadyDead(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     0.0, // Already dead
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      0.0, // Already dead
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Fall at 600 px/sec
@@ -140,10 +133,9 @@ func TestApplyFallDamage_AlreadyDead(t *testing.T) {
 
 func TestApplyFallDamage_NegativeVelocity(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Negative velocity (moving upward) - should not apply damage
@@ -156,10 +148,9 @@ func TestApplyFallDamage_NegativeVelocity(t *testing.T) {
 
 func TestApplyFallDamage_ZeroVelocity(t *testing.T) {
 	player := &entities.Player{
-		AABB:   types.NewAABB(0, 0, 64, 64),
-		HP:     10.0,
-		Hull:   entities.NewHullBase(),
-		Engine: entities.NewEngineBase(),
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Zero velocity - no damage
@@ -169,3 +160,41 @@ func TestApplyFallDamage_ZeroVelocity(t *testing.T) {
 		t.Errorf("Expected no damage at zero velocity, got HP: %f", player.HP)
 	}
 }
+
+func TestApplyFallDamage_CargoLandsHarder(t *testing.T) {
+	player := &entities.Player{
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
+	}
+	player.OreInventory[entities.OrePlatinum] = 10 // 800 kg on a 1500 kg vehicle
+
+	// Fall at 520 px/sec: empty damage 1.0, scaled by the load factor
+	ApplyFallDamage(player, 520.0)
+
+	expected := 10.0 - player.LoadFactor()
+	if math.Abs(float64(player.HP-expected)) > 0.0001 {
+		t.Errorf("Expected HP %f with a loaded hold, got %f", expected, player.HP)
+	}
+}
+
+func TestApplyFallDamage_HardLandingWearsComponents(t *testing.T) {
+	player := &entities.Player{
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
+	}
+
+	// 5 damage wears engine, drill and tank; the hull only loses HP
+	ApplyFallDamage(player, 600.0)
+
+	expected := float32(1 - 5.0*FallWearPerDamage)
+	for _, c := range []entities.Component{player.Engine().Component, player.Drill().Component, player.FuelTank().Component} {
+		if math.Abs(float64(c.Condition()-expected)) > 0.0001 {
+			t.Errorf("Expected %s at condition %f, got %f", c.Name(), expected, c.Condition())
+		}
+	}
+	if player.Hull().Condition() != 1 {
+		t.Error("Landing should not wear the hull component")
+	}
+}
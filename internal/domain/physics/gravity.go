@@ -10,6 +10,14 @@ func ApplyGravity(velocity types.Vec2, dt float32) types.Vec2 {
 	}
 }
 
+// CapFallSpeed limits downward velocity to maxFallSpeed (used by the parachute)
+func CapFallSpeed(velocity types.Vec2, maxFallSpeed float32) types.Vec2 {
+	if velocity.Y > maxFallSpeed {
+		velocity.Y = maxFallSpeed
+	}
+	return velocity
+}
+
 // IntegrateVelocity updates position based on current velocity
 func IntegrateVelocity(position, velocity types.Vec2, dt float32) types.Vec2 {
 	return types.Vec2{
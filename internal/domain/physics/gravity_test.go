@@ -53,3 +53,15 @@ func TestIntegrateVelocity_UpdatesPosition(t *testing.T) {
 			expectedX, expectedY, newPosition.X, newPosition.Y)
 	}
 }
+
+func TestCapFallSpeed_LimitsOnlyDownwardSpeed(t *testing.T) {
+	falling := physics.CapFallSpeed(types.Vec2{X: 50, Y: 900}, physics.ParachuteFallSpeed)
+	if falling.Y != physics.ParachuteFallSpeed || falling.X != 50 {
+		t.Errorf("Expected fall capped at %f with X kept, got %+v", physics.ParachuteFallSpeed, falling)
+	}
+
+	rising := physics.CapFallSpeed(types.Vec2{Y: -300}, physics.ParachuteFallSpeed)
+	if rising.Y != -300 {
+		t.Errorf("Upward speed should be untouched, got %f", rising.Y)
+	}
+}
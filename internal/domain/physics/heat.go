@@ -4,28 +4,29 @@ import (
 	"math"
 
 	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
 // CalculateTemperature returns the temperature in °C at the given Y position
-func CalculateTemperature(playerY float32) float32 {
-	depthBelowGround := playerY - float32(GroundLevelY)
-	if depthBelowGround <= 0 {
-		return float32(BaseTemperature) // At or above ground level
+// The curve runs linearly from cfg.BaseTemperature at ground level to cfg.MaxTemperature at cfg.MaxDepth
+func CalculateTemperature(cfg world.Config, playerY float32) float32 {
+	normalizedDepth := cfg.NormalizedDepth(playerY)
+	if normalizedDepth <= 0 {
+		return cfg.BaseTemperature // At or above ground level
 	}
 
-	maxDepth := float32(MaxUndergroundY) - float32(GroundLevelY)
-	normalizedDepth := depthBelowGround / maxDepth
-	temperature := float32(BaseTemperature) +
-		normalizedDepth*(float32(MaxTemperature)-float32(BaseTemperature))
+	temperature := cfg.BaseTemperature +
+		normalizedDepth*(cfg.MaxTemperature-cfg.BaseTemperature)
 
 	return temperature
 }
 
 // ApplyHeatDamage calculates and applies damage based on depth-based temperature
-func ApplyHeatDamage(player *entities.Player, dt float32) {
-	temperature := CalculateTemperature(player.AABB.Y)
+// Overheating also wears the engine and drill
+func ApplyHeatDamage(player *entities.Player, cfg world.Config, dt float32) {
+	temperature := CalculateTemperature(cfg, player.AABB.Y)
 
-	excessHeat := temperature - player.HeatShield.HeatResistance()
+	excessHeat := temperature - player.HeatResistance() // Heat shield plus any coolant
 	if excessHeat <= 0 {
 		return // Player is within safe temperature range
 	}
@@ -37,4 +38,6 @@ func ApplyHeatDamage(player *entities.Player, dt float32) {
 	damage := damagePerSecond * dt
 
 	player.DealDamage(damage)
+	player.WearComponents(damage*HeatWearPerDamage, entities.SlotEngine, entities.SlotDrill)
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectOverheated, OverheatedDuration, 0))
 }
@@ -5,13 +5,17 @@ import (
 
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/types"
+	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
+// testWorldConfig matches the 640px ground level and 64000px max depth used across these tests
+var testWorldConfig = world.NewConfig(7680, 64000, 640)
+
 // TemperatureTests
 
 func TestCalculateTemperature_AtGroundLevel(t *testing.T) {
 	// At ground level (Y=640), temperature should be 15°C
-	temp := CalculateTemperature(640.0)
+	temp := CalculateTemperature(testWorldConfig, 640.0)
 
 	if temp != 15.0 {
 		t.Errorf("Expected 15°C at ground level, got %f°C", temp)
@@ -20,7 +24,7 @@ func TestCalculateTemperature_AtGroundLevel(t *testing.T) {
 
 func TestCalculateTemperature_AboveGround(t *testing.T) {
 	// Above ground (Y < 640), temperature should be clamped to base (15°C)
-	temp := CalculateTemperature(500.0)
+	temp := CalculateTemperature(testWorldConfig, 500.0)
 
 	if temp != 15.0 {
 		t.Errorf("Expected 15°C above ground, got %f°C", temp)
@@ -29,7 +33,7 @@ func TestCalculateTemperature_AboveGround(t *testing.T) {
 
 func TestCalculateTemperature_AtMaxDepth(t *testing.T) {
 	// At max depth (Y=64000), temperature should be 350°C
-	temp := CalculateTemperature(64000.0)
+	temp := CalculateTemperature(testWorldConfig, 64000.0)
 
 	if temp != 350.0 {
 		t.Errorf("Expected 350°C at max depth, got %f°C", temp)
@@ -40,7 +44,7 @@ func TestCalculateTemperature_Midpoint(t *testing.T) {
 	// Midpoint between ground and max depth
 	// Y = (640 + 64000) / 2 = 32320
 	// temp = 15 + 0.5 * (350 - 15) = 15 + 167.5 = 182.5°C
-	temp := CalculateTemperature(32320.0)
+	temp := CalculateTemperature(testWorldConfig, 32320.0)
 
 	const expected = 182.5
 	const tolerance = 0.1
@@ -52,7 +56,7 @@ func TestCalculateTemperature_Midpoint(t *testing.T) {
 func TestCalculateTemperature_OneQuarter(t *testing.T) {
 	// One quarter down: 640 + 0.25 * (64000 - 640) = 640 + 15840 = 16480
 	// temp = 15 + 0.25 * 335 = 15 + 83.75 = 98.75°C
-	temp := CalculateTemperature(16480.0)
+	temp := CalculateTemperature(testWorldConfig, 16480.0)
 
 	const expected = 98.75
 	const tolerance = 0.1
@@ -64,7 +68,7 @@ func TestCalculateTemperature_OneQuarter(t *testing.T) {
 func TestCalculateTemperature_ThreeQuarters(t *testing.T) {
 	// Three quarters down: 640 + 0.75 * (64000 - 640) = 640 + 47520 = 48160
 	// temp = 15 + 0.75 * 335 = 15 + 251.25 = 266.25°C
-	temp := CalculateTemperature(48160.0)
+	temp := CalculateTemperature(testWorldConfig, 48160.0)
 
 	const expected = 266.25
 	const tolerance = 0.1
@@ -73,19 +77,35 @@ func TestCalculateTemperature_ThreeQuarters(t *testing.T) {
 	}
 }
 
+func TestCalculateTemperature_FollowsWorldConfig(t *testing.T) {
+	// A shallower 51200px world reaches max temperature at its own bottom
+	cfg := world.NewConfig(7680, 51200, 640)
+
+	temp := CalculateTemperature(cfg, 51200.0)
+	if temp != 350.0 {
+		t.Errorf("Expected 350°C at bottom of 51200px world, got %f°C", temp)
+	}
+
+	// Custom curve endpoints are respected
+	cfg.BaseTemperature = 0
+	cfg.MaxTemperature = 100
+	temp = CalculateTemperature(cfg, 640+(51200-640)/2)
+	if temp != 50.0 {
+		t.Errorf("Expected 50°C at midpoint of custom curve, got %f°C", temp)
+	}
+}
+
 // Heat Damage Tests
 
 func TestApplyHeatDamage_NoExcessHeat(t *testing.T) {
 	player := &entities.Player{
-		AABB:      types.NewAABB(0, 640, 64, 64), // At ground level (15°C)
-		HP:        10.0,
-		Hull:      entities.NewHullBase(),
-		Engine:    entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(), // 50°C resistance
+		AABB:    types.NewAABB(0, 640, 64, 64), // At ground level (15°C)
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Temperature 15°C < resistance 50°C, no damage
-	ApplyHeatDamage(player, 0.016) // ~60 FPS
+	ApplyHeatDamage(player, testWorldConfig, 0.016) // ~60 FPS
 
 	if player.HP != 10.0 {
 		t.Errorf("Expected no damage when below resistance, got HP: %f", player.HP)
@@ -96,14 +116,12 @@ func TestApplyHeatDamage_AtResistanceLimit(t *testing.T) {
 	// At 640 + 800 = 1440px, temp = 15 + (800/63360) * 335 ≈ 19.24°C
 	// Resistance 50°C > temp, no damage
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 1440, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, 1440, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player, 0.016)
+	ApplyHeatDamage(player, testWorldConfig, 0.016)
 
 	if player.HP != 10.0 {
 		t.Errorf("Expected no damage within resistance margin, got HP: %f", player.HP)
@@ -116,14 +134,12 @@ func TestApplyHeatDamage_SlightExcess(t *testing.T) {
 	// Excess = 0.35°C (minimal)
 	// damage = 0.5 * (0.35/10)^1.5 * dt
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 7290, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, 7290, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player, 1.0) // 1 second
+	ApplyHeatDamage(player, testWorldConfig, 1.0) // 1 second
 
 	if player.HP >= 10.0 {
 		t.Errorf("Expected some damage with excess heat, got HP: %f", player.HP)
@@ -139,14 +155,12 @@ func TestApplyHeatDamage_SignificantExcess(t *testing.T) {
 	// Excess = 66.04°C
 	// damage/sec ≈ 0.5 * (66.04/10)^1.5 ≈ 0.5 * ~17 ≈ 8.5 HP/sec
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 20590, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, 20590, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player, 1.0) // 1 second
+	ApplyHeatDamage(player, testWorldConfig, 1.0) // 1 second
 
 	// Should take significant damage
 	if player.HP >= 5.0 {
@@ -160,15 +174,13 @@ func TestApplyHeatDamage_SignificantExcess(t *testing.T) {
 func TestApplyHeatDamage_ClampsAtZero(t *testing.T) {
 	// Very deep: temperature far exceeds resistance
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 64000, 64, 64), // Max depth (350°C)
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(), // 50°C resistance
+		AABB:    types.NewAABB(0, 64000, 64, 64), // Max depth (350°C)
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	// Apply 10 seconds of heat damage
-	ApplyHeatDamage(player, 10.0)
+	ApplyHeatDamage(player, testWorldConfig, 10.0)
 
 	if player.HP != 0.0 {
 		t.Errorf("Expected HP clamped at 0, got HP: %f", player.HP)
@@ -179,14 +191,13 @@ func TestApplyHeatDamage_UpgradedShield(t *testing.T) {
 	// At depth with 140°C temp, Mk2 shield (140°C resistance) should take minimal/no damage
 	// Y = 640 + (140-15)/335 * 63360 = 640 + 23647 ≈ 24287
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 24287, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldMk2(), // 140°C resistance
+		AABB:    types.NewAABB(0, 24287, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
+	player.Loadout[entities.SlotHeatShield] = entities.NewComponent(entities.SlotHeatShield, 2) // 140°C resistance
 
-	ApplyHeatDamage(player, 0.016) // One frame at 60 FPS
+	ApplyHeatDamage(player, testWorldConfig, 0.016) // One frame at 60 FPS
 
 	// At this depth, temp ≈ 140°C, resistance = 140°C
 	// Allow for floating-point tolerance (tiny rounding errors)
@@ -200,23 +211,19 @@ func TestApplyHeatDamage_ScalesWithDeltaTime(t *testing.T) {
 	depth := float32(20590.0) // ~116°C temp
 
 	player1 := &entities.Player{
-		AABB:       types.NewAABB(0, depth, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, depth, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	player2 := &entities.Player{
-		AABB:       types.NewAABB(0, depth, 64, 64),
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, depth, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player1, 0.5)  // Half second
-	ApplyHeatDamage(player2, 1.0)  // Full second
+	ApplyHeatDamage(player1, testWorldConfig, 0.5) // Half second
+	ApplyHeatDamage(player2, testWorldConfig, 1.0) // Full second
 
 	// Damage should roughly double with 2x delta time
 	damage1 := 10.0 - player1.HP
@@ -229,14 +236,12 @@ func TestApplyHeatDamage_ScalesWithDeltaTime(t *testing.T) {
 
 func TestApplyHeatDamage_AlreadyDead(t *testing.T) {
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 64000, 64, 64),
-		HP:         0.0, // Already dead
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, 64000, 64, 64),
+		HP:      0.0, // Already dead
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player, 10.0)
+	ApplyHeatDamage(player, testWorldConfig, 10.0)
 
 	// Should remain at 0, not go negative
 	if player.HP != 0.0 {
@@ -247,14 +252,12 @@ func TestApplyHeatDamage_AlreadyDead(t *testing.T) {
 func TestApplyHeatDamage_PreservesPartialHealth(t *testing.T) {
 	// Player at 8 HP with excess heat
 	player := &entities.Player{
-		AABB:       types.NewAABB(0, 20590, 64, 64), // ~116°C
-		HP:         8.0, // Damaged
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, 20590, 64, 64), // ~116°C
+		HP:      8.0,                             // Damaged
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(player, 0.5)
+	ApplyHeatDamage(player, testWorldConfig, 0.5)
 
 	// Should reduce proportionally but not clamp to 0 if still above 0
 	if player.HP < 0.0 || player.HP >= 8.0 {
@@ -268,23 +271,19 @@ func TestApplyHeatDamage_MoreDamageThanExposure(t *testing.T) {
 	// Deep location: temp ≈ 200°C, excess = 150°C
 
 	shallowPlayer := &entities.Player{
-		AABB:       types.NewAABB(0, float32(6650), 64, 64), // Shallow depth
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, float32(6650), 64, 64), // Shallow depth
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
 	deepPlayer := &entities.Player{
-		AABB:       types.NewAABB(0, float32(30000), 64, 64), // Deeper depth
-		HP:         10.0,
-		Hull:       entities.NewHullBase(),
-		Engine:     entities.NewEngineBase(),
-		HeatShield: entities.NewHeatShieldBase(),
+		AABB:    types.NewAABB(0, float32(30000), 64, 64), // Deeper depth
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
 	}
 
-	ApplyHeatDamage(shallowPlayer, 1.0)
-	ApplyHeatDamage(deepPlayer, 1.0)
+	ApplyHeatDamage(shallowPlayer, testWorldConfig, 1.0)
+	ApplyHeatDamage(deepPlayer, testWorldConfig, 1.0)
 
 	shallowDamage := 10.0 - shallowPlayer.HP
 	deepDamage := 10.0 - deepPlayer.HP
@@ -294,3 +293,29 @@ func TestApplyHeatDamage_MoreDamageThanExposure(t *testing.T) {
 		t.Errorf("Expected more damage at deeper location. Shallow: %f, Deep: %f", shallowDamage, deepDamage)
 	}
 }
+
+func TestApplyHeatDamage_OverheatsOnlyAboveResistance(t *testing.T) {
+	cool := entities.NewPlayer(0, 640)  // 15°C, below 50°C resistance
+	hot := entities.NewPlayer(0, 20590) // ~116°C
+
+	ApplyHeatDamage(cool, testWorldConfig, 0.016)
+	ApplyHeatDamage(hot, testWorldConfig, 0.016)
+
+	if cool.Status.Has(entities.EffectOverheated) {
+		t.Error("Player within resistance should not overheat")
+	}
+	if !hot.Status.Has(entities.EffectOverheated) {
+		t.Error("Player taking heat damage should be overheated")
+	}
+}
+
+func TestApplyHeatDamage_CoolantRaisesResistance(t *testing.T) {
+	player := entities.NewPlayer(0, 7290) // ~50.35°C, just over base resistance
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectCoolant, 45, 10))
+
+	ApplyHeatDamage(player, testWorldConfig, 1.0)
+
+	if player.HP != player.Hull().MaxHP() {
+		t.Errorf("Coolant should keep the player within resistance, got HP: %f", player.HP)
+	}
+}
@@ -0,0 +1,23 @@
+package physics
+
+import "github.com/Kishlin/drill-game/internal/domain/entities"
+
+// ApplyImpactDamage handles ramming a wall or hitting a ceiling.
+// speed is the velocity along the blocked axis just before the collision stopped it (either sign).
+// Damage follows the landing formula with its own threshold; hard enough impacts also knock
+// the vehicle back. Returns the rebound velocity along that axis (0 below the threshold).
+func ApplyImpactDamage(player *entities.Player, speed float32) float32 {
+	impactSpeed := speed
+	if impactSpeed < 0 {
+		impactSpeed = -impactSpeed
+	}
+	if impactSpeed < ImpactDamageThreshold {
+		return 0
+	}
+
+	damage := (impactSpeed - ImpactDamageThreshold) / ImpactDamageDivisor * player.LoadFactor()
+
+	dealImpactDamage(player, damage)
+
+	return -speed * ImpactRestitution
+}
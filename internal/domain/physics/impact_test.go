@@ -0,0 +1,70 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/types"
+)
+
+func newImpactTestPlayer() *entities.Player {
+	return &entities.Player{
+		AABB:    types.NewAABB(0, 0, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
+	}
+}
+
+func TestApplyImpactDamage_BelowThreshold(t *testing.T) {
+	player := newImpactTestPlayer()
+
+	rebound := ApplyImpactDamage(player, 300.0)
+
+	if player.HP != 10.0 || rebound != 0 {
+		t.Errorf("Expected no damage and no bounce, got HP %f, rebound %f", player.HP, rebound)
+	}
+}
+
+func TestApplyImpactDamage_EitherDirection(t *testing.T) {
+	right := newImpactTestPlayer()
+	up := newImpactTestPlayer()
+
+	// (450 - 350) / 100 = 1 damage
+	reboundRight := ApplyImpactDamage(right, 450.0)
+	reboundUp := ApplyImpactDamage(up, -450.0)
+
+	if right.HP != 9.0 || up.HP != 9.0 {
+		t.Errorf("Expected 1 damage on any axis, got HP %f and %f", right.HP, up.HP)
+	}
+	if reboundRight != -450.0*ImpactRestitution || reboundUp != 450.0*ImpactRestitution {
+		t.Errorf("Expected rebounds opposite the impact, got %f and %f", reboundRight, reboundUp)
+	}
+}
+
+func TestApplyImpactDamage_HullAbsorbsShock(t *testing.T) {
+	player := newImpactTestPlayer()
+	player.Loadout[entities.SlotHull] = entities.NewComponent(entities.SlotHull, 2)
+	absorption := player.Hull().ShockAbsorption()
+
+	ApplyImpactDamage(player, 550.0)
+
+	expected := 10.0 - 2.0*(1-absorption)
+	if math.Abs(float64(player.HP-expected)) > 0.0001 {
+		t.Errorf("Expected HP %f with %.0f%% absorbed, got %f", expected, absorption*100, player.HP)
+	}
+}
+
+func TestApplyFallDamage_HullAbsorbsShock(t *testing.T) {
+	player := newImpactTestPlayer()
+	player.Loadout[entities.SlotHull] = entities.NewComponent(entities.SlotHull, 5)
+	absorption := player.Hull().ShockAbsorption()
+
+	// Fall at 600 px/sec: 5 damage before absorption
+	ApplyFallDamage(player, 600.0)
+
+	expected := 10.0 - 5.0*(1-absorption)
+	if math.Abs(float64(player.HP-expected)) > 0.0001 {
+		t.Errorf("Expected HP %f with %.0f%% absorbed, got %f", expected, absorption*100, player.HP)
+	}
+}
@@ -0,0 +1,31 @@
+package physics
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// CalculatePressure returns the pressure in atm at the given Y position
+// The curve runs linearly from cfg.BasePressure at ground level to cfg.MaxPressure at cfg.MaxDepth
+func CalculatePressure(cfg world.Config, playerY float32) float32 {
+	normalizedDepth := cfg.NormalizedDepth(playerY)
+	if normalizedDepth <= 0 {
+		return cfg.BasePressure // At or above ground level
+	}
+
+	return cfg.BasePressure + normalizedDepth*(cfg.MaxPressure-cfg.BasePressure)
+}
+
+// ApplyPressureDamage deals damage while the pressure exceeds the hull's rating
+// Damage grows linearly with the excess, so only a better hull makes extreme depths safe
+func ApplyPressureDamage(player *entities.Player, cfg world.Config, dt float32) {
+	pressure := CalculatePressure(cfg, player.AABB.Y)
+
+	excessPressure := pressure - player.Hull().PressureRating()
+	if excessPressure <= 0 {
+		return // Hull holds at this depth
+	}
+
+	// damage = excessPressure / divisor * dt
+	player.DealDamage(excessPressure / PressureDamageDivisor * dt)
+}
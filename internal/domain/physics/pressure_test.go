@@ -0,0 +1,83 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/types"
+)
+
+func newPressureTestPlayer(y float32) *entities.Player {
+	return &entities.Player{
+		AABB:    types.NewAABB(0, y, 64, 64),
+		HP:      10.0,
+		Loadout: entities.NewBaseLoadout(),
+	}
+}
+
+func TestCalculatePressure_GroundAndMaxDepth(t *testing.T) {
+	if p := CalculatePressure(testWorldConfig, 500.0); p != 1.0 {
+		t.Errorf("Expected 1 atm above ground, got %f", p)
+	}
+	if p := CalculatePressure(testWorldConfig, 640.0); p != 1.0 {
+		t.Errorf("Expected 1 atm at ground level, got %f", p)
+	}
+	if p := CalculatePressure(testWorldConfig, 64000.0); p != 1000.0 {
+		t.Errorf("Expected 1000 atm at max depth, got %f", p)
+	}
+}
+
+func TestCalculatePressure_Midpoint(t *testing.T) {
+	// Y = 32320 is halfway: 1 + 0.5 * 999 = 500.5 atm
+	p := CalculatePressure(testWorldConfig, 32320.0)
+
+	if math.Abs(float64(p-500.5)) > 0.1 {
+		t.Errorf("Expected ~500.5 atm at midpoint, got %f", p)
+	}
+}
+
+func TestApplyPressureDamage_WithinHullRating(t *testing.T) {
+	// Base hull is rated for 400 atm; midpoint is ~500 atm, a quarter down is ~250 atm
+	player := newPressureTestPlayer(16480.0)
+
+	ApplyPressureDamage(player, testWorldConfig, 1.0)
+
+	if player.HP != 10.0 {
+		t.Errorf("Expected no damage within the hull rating, got HP %f", player.HP)
+	}
+}
+
+func TestApplyPressureDamage_ExceedsHullRating(t *testing.T) {
+	player := newPressureTestPlayer(32320.0)
+
+	ApplyPressureDamage(player, testWorldConfig, 0.5)
+
+	// Excess ~100.5 atm / 50 = ~2.01 HP/s, for half a second
+	expected := float32(10.0 - (500.5-400)/PressureDamageDivisor*0.5)
+	if math.Abs(float64(player.HP-expected)) > 0.01 {
+		t.Errorf("Expected HP ~%f, got %f", expected, player.HP)
+	}
+}
+
+func TestApplyPressureDamage_UpgradedHullHolds(t *testing.T) {
+	player := newPressureTestPlayer(32320.0)
+	player.Loadout[entities.SlotHull] = entities.NewComponent(entities.SlotHull, 2) // 600 atm
+
+	ApplyPressureDamage(player, testWorldConfig, 1.0)
+
+	if player.HP != 10.0 {
+		t.Errorf("Hull Mk2 should hold at the midpoint, got HP %f", player.HP)
+	}
+}
+
+func TestApplyPressureDamage_TopHullSafeAtMaxDepth(t *testing.T) {
+	player := newPressureTestPlayer(64000.0)
+	player.Loadout[entities.SlotHull] = entities.NewComponent(entities.SlotHull, entities.LineFor(entities.SlotHull).MaxTier())
+
+	ApplyPressureDamage(player, testWorldConfig, 1.0)
+
+	if player.HP != 10.0 {
+		t.Errorf("Top hull should be safe at max depth, got HP %f", player.HP)
+	}
+}
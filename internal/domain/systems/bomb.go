@@ -0,0 +1,130 @@
+package systems
+
+import (
+	"math"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+const (
+	BlastStunDuration = 0.75 // Seconds stunned at the center of a blast (scaled by falloff)
+	BlastBurnDuration = 3.0  // Seconds the player burns after being caught in a blast
+	BlastBurnDPS      = 1.0  // Burning damage per second at the center of a blast (scaled by falloff)
+)
+
+// BombSystem owns placed bombs: their fuses, detonations and chain reactions
+type BombSystem struct {
+	world   *world.World
+	pickups *PickupSystem
+	bombs   []*entities.Bomb
+}
+
+func NewBombSystem(w *world.World, pickups *PickupSystem) *BombSystem {
+	return &BombSystem{world: w, pickups: pickups}
+}
+
+// PlaceBomb drops a bomb with the given blast centered on the pixel position
+func (bs *BombSystem) PlaceBomb(spec entities.BombSpec, centerX, centerY float32) *entities.Bomb {
+	bomb := entities.NewBomb(centerX, centerY, spec)
+	bs.bombs = append(bs.bombs, bomb)
+	return bomb
+}
+
+// UpdateBombs moves bombs, ticks their fuses and detonates those that run out
+// A blast sets off every other bomb inside its radius in the same frame
+func (bs *BombSystem) UpdateBombs(player *entities.Player, dt float32) {
+	for _, bomb := range bs.bombs {
+		bomb.AABB, bomb.Velocity, bomb.OnGround = moveLooseBody(bs.world, bomb.AABB, bomb.Velocity, dt)
+		bomb.Fuse -= dt
+	}
+
+	for {
+		bomb := bs.nextExpiredBomb()
+		if bomb == nil {
+			return
+		}
+		bs.detonate(bomb, player)
+	}
+}
+
+// nextExpiredBomb removes and returns the first bomb whose fuse has run out, or nil
+func (bs *BombSystem) nextExpiredBomb() *entities.Bomb {
+	for i, bomb := range bs.bombs {
+		if bomb.Fuse <= 0 {
+			bs.bombs = append(bs.bombs[:i], bs.bombs[i+1:]...)
+			return bomb
+		}
+	}
+	return nil
+}
+
+// detonate destroys tiles in the blast, hurts and pushes the player and primes nearby bombs
+func (bs *BombSystem) detonate(bomb *entities.Bomb, player *entities.Player) {
+	centerX, centerY := bomb.Center()
+	radius := float32(bomb.Spec.Radius) * world.TileSize
+
+	// Tiles in the radius are destroyed; their ore is blasted loose as pickups
+	gridX := int(centerX / world.TileSize)
+	gridY := int(centerY / world.TileSize)
+	bs.world.ForEachTileInRadius(gridX, gridY, bomb.Spec.Radius, func(tileX, tileY int, _ *entities.Tile) {
+		tile, ok := bs.world.DrillTileAtGrid(tileX, tileY)
+		if ok && tile.Type == entities.TileTypeOre {
+			bs.pickups.SpawnOre(tile.OreType, (float32(tileX)+0.5)*world.TileSize, (float32(tileY)+0.5)*world.TileSize)
+		}
+	})
+
+	bs.applyBlastToPlayer(bomb.Spec, centerX, centerY, radius, player)
+
+	// Chain reaction: other bombs caught in the blast go off this frame
+	for _, other := range bs.bombs {
+		otherX, otherY := other.Center()
+		if distance(centerX, centerY, otherX, otherY) <= radius {
+			other.Fuse = 0
+		}
+	}
+}
+
+// applyBlastToPlayer deals damage, knockback, a stun and burning, all falling off linearly with distance
+func (bs *BombSystem) applyBlastToPlayer(spec entities.BombSpec, centerX, centerY, radius float32, player *entities.Player) {
+	playerX, playerY := playerCenter(player)
+	dist := distance(centerX, centerY, playerX, playerY)
+	falloff := BlastFalloff(dist, radius)
+	if falloff == 0 {
+		return
+	}
+
+	player.DealDamage(spec.Damage * falloff)
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectStunned, BlastStunDuration*falloff, 0))
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectBurning, BlastBurnDuration, BlastBurnDPS*falloff))
+
+	// Push away from the blast; straight up when sitting right on top of it
+	dirX, dirY := float32(0), float32(-1)
+	if dist > 0 {
+		dirX = (playerX - centerX) / dist
+		dirY = (playerY - centerY) / dist
+	}
+	player.Velocity.X += dirX * spec.Knockback * falloff
+	player.Velocity.Y += dirY * spec.Knockback * falloff
+	player.OnGround = false
+}
+
+// BlastFalloff returns the share of a blast's strength felt at dist pixels from its center
+// 1 at the center, falling linearly to 0 at the edge of the radius
+func BlastFalloff(dist, radius float32) float32 {
+	if radius <= 0 || dist >= radius {
+		return 0
+	}
+	return 1 - dist/radius
+}
+
+func distance(x1, y1, x2, y2 float32) float32 {
+	dx := x2 - x1
+	dy := y2 - y1
+	return float32(math.Sqrt(float64(dx*dx + dy*dy)))
+}
+
+// GetBombs returns every placed bomb that hasn't detonated yet
+func (bs *BombSystem) GetBombs() []*entities.Bomb {
+	return bs.bombs
+}
@@ -0,0 +1,171 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// newBombTestWorld returns a world with an empty pocket at grid (20, 30) for bombs to sit in
+func newBombTestWorld() (*world.World, *BombSystem, *PickupSystem) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	w.GetTileAtGrid(20, 30) // Load the chunk first so generation does not overwrite
+	for gridY := 28; gridY <= 32; gridY++ {
+		for gridX := 18; gridX <= 22; gridX++ {
+			w.SetTile(gridX, gridY, entities.NewTile(entities.TileTypeDirt))
+		}
+	}
+	w.SetTile(20, 30, entities.NewTile(entities.TileTypeEmpty))
+
+	pickups := NewPickupSystem(w)
+	return w, NewBombSystem(w, pickups), pickups
+}
+
+// pocketCenter is the pixel center of the empty pocket in newBombTestWorld
+const pocketCenterX, pocketCenterY = 20*world.TileSize + world.TileSize/2, 30*world.TileSize + world.TileSize/2
+
+func updateBombs(bs *BombSystem, player *entities.Player, seconds float32) {
+	for elapsed := float32(0); elapsed < seconds; elapsed += 1.0 / 60.0 {
+		bs.UpdateBombs(player, 1.0/60.0)
+	}
+}
+
+func TestBombSystem_FuseDelaysDetonation(t *testing.T) {
+	w, bs, _ := newBombTestWorld()
+	player := entities.NewPlayer(2000, 500) // Far away from the blast
+
+	bs.PlaceBomb(entities.BombSpecs[entities.ItemBomb], pocketCenterX, pocketCenterY)
+
+	updateBombs(bs, player, entities.BombFuse-0.5)
+	if w.GetTileAtGrid(21, 30) == nil {
+		t.Fatal("Tiles should survive until the fuse runs out")
+	}
+	if len(bs.GetBombs()) != 1 {
+		t.Fatalf("Bomb should still be placed, got %d", len(bs.GetBombs()))
+	}
+
+	updateBombs(bs, player, 1.0)
+	if w.GetTileAtGrid(21, 30) != nil {
+		t.Error("Tiles inside the radius should be destroyed by the blast")
+	}
+	if w.GetTileAtGrid(23, 30) == nil {
+		t.Error("Tiles outside the radius should survive")
+	}
+	if len(bs.GetBombs()) != 0 {
+		t.Errorf("Detonated bomb should be removed, got %d", len(bs.GetBombs()))
+	}
+}
+
+func TestBombSystem_BlastDropsOreAsPickups(t *testing.T) {
+	w, bs, pickups := newBombTestWorld()
+	player := entities.NewPlayer(2000, 500)
+	w.SetTile(21, 29, entities.NewOreTile(entities.OreGold))
+
+	bomb := bs.PlaceBomb(entities.BombSpecs[entities.ItemBomb], pocketCenterX, pocketCenterY)
+	bomb.Fuse = 0
+	bs.UpdateBombs(player, 1.0/60.0)
+
+	found := pickups.GetPickups()
+	if len(found) != 1 || found[0].OreType != entities.OreGold {
+		t.Fatalf("Expected one gold pickup from the blast, got %+v", found)
+	}
+	if player.OreInventory[entities.OreGold] != 0 {
+		t.Error("Blasted ore should not go straight into the hold")
+	}
+}
+
+func TestBombSystem_BlastDamageFallsOffWithDistance(t *testing.T) {
+	_, bs, _ := newBombTestWorld()
+	spec := entities.BombSpecs[entities.ItemBigBomb]
+	radius := float32(spec.Radius) * world.TileSize
+
+	near := entities.NewPlayer(pocketCenterX-entities.PlayerWidth/2+radius*0.25, pocketCenterY-entities.PlayerHeight/2)
+	far := entities.NewPlayer(pocketCenterX-entities.PlayerWidth/2+radius*0.75, pocketCenterY-entities.PlayerHeight/2)
+	outside := entities.NewPlayer(pocketCenterX+radius*2, pocketCenterY)
+
+	for _, player := range []*entities.Player{near, far, outside} {
+		bomb := bs.PlaceBomb(spec, pocketCenterX, pocketCenterY)
+		bomb.Fuse = 0
+		bs.UpdateBombs(player, 1.0/60.0)
+	}
+
+	nearDamage := near.Hull().MaxHP() - near.HP
+	farDamage := far.Hull().MaxHP() - far.HP
+
+	if nearDamage <= farDamage || farDamage <= 0 {
+		t.Errorf("Closer player should take more damage: near %f, far %f", nearDamage, farDamage)
+	}
+	if outside.HP != outside.Hull().MaxHP() {
+		t.Errorf("Player outside the radius should be unhurt, lost %f HP", outside.Hull().MaxHP()-outside.HP)
+	}
+	if near.Velocity.X <= far.Velocity.X || far.Velocity.X <= 0 {
+		t.Errorf("Knockback should push away and fall off: near %f, far %f", near.Velocity.X, far.Velocity.X)
+	}
+	if !near.Status.Has(entities.EffectStunned) || !near.Status.Has(entities.EffectBurning) {
+		t.Error("Player caught in the blast should be stunned and burning")
+	}
+	if near.Status.Magnitude(entities.EffectBurning) <= far.Status.Magnitude(entities.EffectBurning) {
+		t.Error("Burning should fall off with distance")
+	}
+	if outside.Status.Has(entities.EffectStunned) || outside.Status.Has(entities.EffectBurning) {
+		t.Error("Player outside the radius should have no blast statuses")
+	}
+}
+
+func TestBlastFalloff(t *testing.T) {
+	tests := []struct {
+		dist, radius, expected float32
+	}{
+		{0, 128, 1},
+		{64, 128, 0.5},
+		{128, 128, 0},
+		{200, 128, 0},
+		{10, 0, 0},
+	}
+
+	for _, test := range tests {
+		if got := BlastFalloff(test.dist, test.radius); got != test.expected {
+			t.Errorf("BlastFalloff(%f, %f) = %f, expected %f", test.dist, test.radius, got, test.expected)
+		}
+	}
+}
+
+func TestBombSystem_ChainDetonation(t *testing.T) {
+	_, bs, _ := newBombTestWorld()
+	player := entities.NewPlayer(2000, 500)
+	spec := entities.BombSpecs[entities.ItemBomb]
+
+	first := bs.PlaceBomb(spec, pocketCenterX, pocketCenterY)
+	bs.PlaceBomb(spec, pocketCenterX+world.TileSize, pocketCenterY)              // Inside the blast
+	distant := bs.PlaceBomb(spec, pocketCenterX+world.TileSize*6, pocketCenterY) // Outside the blast
+
+	first.Fuse = 0
+	bs.UpdateBombs(player, 1.0/60.0)
+
+	bombs := bs.GetBombs()
+	if len(bombs) != 1 || bombs[0] != distant {
+		t.Errorf("Only the distant bomb should remain, got %d bombs", len(bombs))
+	}
+}
+
+func TestItemSystem_BombIsPlacedNotDetonated(t *testing.T) {
+	w, bs, _ := newBombTestWorld()
+	is := NewItemSystem(entities.NewDefaultItemRegistry(), bs, 0, 0)
+	player := entities.NewPlayer(pocketCenterX-entities.PlayerWidth/2, pocketCenterY-entities.PlayerHeight/2)
+	player.ItemInventory[entities.ItemBomb] = 2
+	player.SelectedItem = entities.ItemBomb
+
+	is.ProcessItemUsage(player, input.InputState{UseSelectedItem: true})
+
+	if player.ItemInventory[entities.ItemBomb] != 1 {
+		t.Errorf("One bomb should be used up, got %d left", player.ItemInventory[entities.ItemBomb])
+	}
+	if len(bs.GetBombs()) != 1 {
+		t.Fatalf("Expected one placed bomb, got %d", len(bs.GetBombs()))
+	}
+	if w.GetTileAtGrid(21, 30) == nil {
+		t.Error("Placing a bomb should not destroy tiles immediately")
+	}
+}
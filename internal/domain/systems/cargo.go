@@ -0,0 +1,123 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+)
+
+// DroppedOre is ore that left (or never entered) the hold at a world position
+type DroppedOre struct {
+	X, Y    float32 // Pixel position the ore was dropped at
+	OreType entities.OreType
+}
+
+// pendingOre is new ore waiting for the player's answer under CargoPolicyPrompt
+type pendingOre struct {
+	oreType entities.OreType
+	x, y    float32
+}
+
+type CargoSystem struct {
+	pending *pendingOre
+	dropped []DroppedOre
+}
+
+func NewCargoSystem() *CargoSystem {
+	return &CargoSystem{}
+}
+
+// CollectOre stores newly dug ore, applying the player's cargo policy when the hold is full
+// x, y is where the ore was dug, used if the ore is left in the world
+func (cs *CargoSystem) CollectOre(player *entities.Player, oreType entities.OreType, x, y float32) {
+	if player.AddOre(oreType) {
+		return
+	}
+
+	switch player.CargoPolicy {
+	case entities.CargoPolicyDropLeastValuable:
+		if !cs.swapForLeastValuable(player, oreType) {
+			cs.drop(oreType, x, y)
+		}
+	case entities.CargoPolicyPrompt:
+		// A newer ore replaces an unanswered prompt; the older one stays where it was dug
+		if cs.pending != nil {
+			cs.drop(cs.pending.oreType, cs.pending.x, cs.pending.y)
+		}
+		cs.pending = &pendingOre{oreType: oreType, x: x, y: y}
+	default:
+		cs.drop(oreType, x, y)
+	}
+}
+
+// ProcessCargo handles policy cycling, answers to the cargo-full prompt and manual jettison
+// Runs even during drilling animation so the hold can be managed while digging
+func (cs *CargoSystem) ProcessCargo(player *entities.Player, inputState input.InputState) {
+	if inputState.CycleCargoPolicy {
+		player.CargoPolicy = player.CargoPolicy.Next()
+	}
+
+	if cs.pending != nil {
+		if inputState.KeepNewOre {
+			// The hold may have gained room (sold or jettisoned) while the prompt was up
+			// With nothing cheaper to swap out, the prompt stays open until room is made or the ore is discarded
+			if player.AddOre(cs.pending.oreType) || cs.swapForLeastValuable(player, cs.pending.oreType) {
+				cs.pending = nil
+			}
+		} else if inputState.DiscardNewOre {
+			cs.drop(cs.pending.oreType, cs.pending.x, cs.pending.y)
+			cs.pending = nil
+		}
+	}
+
+	if inputState.JettisonOre > 0 {
+		cs.jettison(player, entities.OreType(inputState.JettisonOre-1))
+	}
+}
+
+// swapForLeastValuable drops the cheapest held ore to make room for oreType
+// Returns false when nothing cheaper is held, so the new ore isn't worth keeping
+func (cs *CargoSystem) swapForLeastValuable(player *entities.Player, oreType entities.OreType) bool {
+	cheapest, ok := player.LeastValuableOre()
+	if !ok || entities.OreValues[cheapest] >= entities.OreValues[oreType] {
+		return false
+	}
+
+	player.RemoveOre(cheapest)
+	player.AddOre(oreType)
+	x, y := playerCenter(player)
+	cs.drop(cheapest, x, y)
+	return true
+}
+
+// jettison throws one unit of the given ore type out of the hold
+func (cs *CargoSystem) jettison(player *entities.Player, oreType entities.OreType) {
+	if !player.RemoveOre(oreType) {
+		return
+	}
+	x, y := playerCenter(player)
+	cs.drop(oreType, x, y)
+}
+
+func (cs *CargoSystem) drop(oreType entities.OreType, x, y float32) {
+	cs.dropped = append(cs.dropped, DroppedOre{X: x, Y: y, OreType: oreType})
+}
+
+// TakeDroppedOre returns ore dropped since the last call and clears the list
+func (cs *CargoSystem) TakeDroppedOre() []DroppedOre {
+	dropped := cs.dropped
+	cs.dropped = nil
+	return dropped
+}
+
+// GetPendingOre returns the ore awaiting a keep/discard answer, if any
+func (cs *CargoSystem) GetPendingOre() (entities.OreType, bool) {
+	if cs.pending == nil {
+		return 0, false
+	}
+	return cs.pending.oreType, true
+}
+
+// playerCenter returns the pixel center of the player's AABB
+func playerCenter(player *entities.Player) (float32, float32) {
+	return player.AABB.X + player.AABB.Width/2, player.AABB.Y + player.AABB.Height/2
+}
@@ -0,0 +1,222 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+)
+
+// fullHoldPlayer returns a player whose base hold (10) is full of copper
+func fullHoldPlayer() *entities.Player {
+	player := entities.NewPlayer(0, 0)
+	for i := 0; i < player.CargoHold().Capacity(); i++ {
+		player.AddOre(entities.OreCopper)
+	}
+	return player
+}
+
+func TestCargoSystem_DropLeastValuableKeepsBetterOre(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyDropLeastValuable
+
+	cs.CollectOre(player, entities.OreDiamond, 100, 200)
+
+	if player.OreInventory[entities.OreDiamond] != 1 {
+		t.Errorf("Expected diamond to be kept, got %d", player.OreInventory[entities.OreDiamond])
+	}
+	if player.OreInventory[entities.OreCopper] != 9 {
+		t.Errorf("Expected one copper dropped to make room, got %d", player.OreInventory[entities.OreCopper])
+	}
+
+	dropped := cs.TakeDroppedOre()
+	if len(dropped) != 1 || dropped[0].OreType != entities.OreCopper {
+		t.Fatalf("Expected one dropped copper, got %+v", dropped)
+	}
+	if len(cs.TakeDroppedOre()) != 0 {
+		t.Error("TakeDroppedOre should clear the list")
+	}
+}
+
+func TestCargoSystem_DropLeastValuableRefusesCheaperOre(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+
+	// Copper is not worth more than the copper already held
+	cs.CollectOre(player, entities.OreCopper, 100, 200)
+
+	if player.OreInventory[entities.OreCopper] != 10 {
+		t.Errorf("Hold should be unchanged, got %d copper", player.OreInventory[entities.OreCopper])
+	}
+
+	dropped := cs.TakeDroppedOre()
+	if len(dropped) != 1 || dropped[0].X != 100 || dropped[0].Y != 200 {
+		t.Errorf("New ore should be dropped where it was dug, got %+v", dropped)
+	}
+}
+
+func TestCargoSystem_LeaveInWorldDropsNewOre(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyLeaveInWorld
+
+	cs.CollectOre(player, entities.OreDiamond, 100, 200)
+
+	if player.OreInventory[entities.OreDiamond] != 0 {
+		t.Error("LeaveInWorld should never swap ore")
+	}
+	dropped := cs.TakeDroppedOre()
+	if len(dropped) != 1 || dropped[0].OreType != entities.OreDiamond {
+		t.Errorf("Expected the diamond to be left behind, got %+v", dropped)
+	}
+}
+
+func TestCargoSystem_PromptWaitsForAnswer(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyPrompt
+
+	cs.CollectOre(player, entities.OreGold, 100, 200)
+
+	if oreType, pending := cs.GetPendingOre(); !pending || oreType != entities.OreGold {
+		t.Fatalf("Expected gold to await an answer, got %v (pending=%v)", oreType, pending)
+	}
+
+	// No answer yet: nothing changes
+	cs.ProcessCargo(player, input.InputState{})
+	if _, pending := cs.GetPendingOre(); !pending {
+		t.Fatal("Prompt should stay open until answered")
+	}
+
+	cs.ProcessCargo(player, input.InputState{KeepNewOre: true})
+
+	if _, pending := cs.GetPendingOre(); pending {
+		t.Error("Prompt should close once answered")
+	}
+	if player.OreInventory[entities.OreGold] != 1 || player.OreInventory[entities.OreCopper] != 9 {
+		t.Errorf("Keeping gold should drop one copper, got gold=%d copper=%d",
+			player.OreInventory[entities.OreGold], player.OreInventory[entities.OreCopper])
+	}
+}
+
+func TestCargoSystem_PromptKeepUsesRoomFreedWhilePending(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyPrompt
+
+	cs.CollectOre(player, entities.OreGold, 100, 200)
+	cs.ProcessCargo(player, input.InputState{JettisonOre: int(entities.OreCopper) + 1})
+	cs.TakeDroppedOre()
+
+	cs.ProcessCargo(player, input.InputState{KeepNewOre: true})
+
+	if player.OreInventory[entities.OreGold] != 1 || player.OreInventory[entities.OreCopper] != 9 {
+		t.Errorf("Keeping gold should fill the freed slot, got gold=%d copper=%d",
+			player.OreInventory[entities.OreGold], player.OreInventory[entities.OreCopper])
+	}
+	if dropped := cs.TakeDroppedOre(); len(dropped) != 0 {
+		t.Errorf("Nothing should be dropped when the hold has room, got %+v", dropped)
+	}
+}
+
+func TestCargoSystem_PromptKeepIntoEmptiedHold(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyPrompt
+
+	cs.CollectOre(player, entities.OreGold, 100, 200)
+	for player.RemoveOre(entities.OreCopper) {
+	}
+
+	cs.ProcessCargo(player, input.InputState{KeepNewOre: true})
+
+	if player.OreInventory[entities.OreGold] != 1 {
+		t.Errorf("Keeping gold into an empty hold should store it, got %d", player.OreInventory[entities.OreGold])
+	}
+	if dropped := cs.TakeDroppedOre(); len(dropped) != 0 {
+		t.Errorf("Nothing should be dropped, got %+v", dropped)
+	}
+}
+
+func TestCargoSystem_PromptKeepWithNothingCheaperStaysOpen(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyPrompt
+
+	// Copper is not worth more than the copper already held
+	cs.CollectOre(player, entities.OreCopper, 100, 200)
+	cs.ProcessCargo(player, input.InputState{KeepNewOre: true})
+
+	if oreType, pending := cs.GetPendingOre(); !pending || oreType != entities.OreCopper {
+		t.Fatalf("Keep with nothing cheaper to swap should leave the prompt open, got %v (pending=%v)", oreType, pending)
+	}
+	if player.OreInventory[entities.OreCopper] != 10 {
+		t.Errorf("Hold should be unchanged, got %d copper", player.OreInventory[entities.OreCopper])
+	}
+	if dropped := cs.TakeDroppedOre(); len(dropped) != 0 {
+		t.Errorf("Keep should not drop the new ore, got %+v", dropped)
+	}
+
+	// Making room answers the open prompt on the next Keep
+	cs.ProcessCargo(player, input.InputState{JettisonOre: int(entities.OreCopper) + 1})
+	cs.ProcessCargo(player, input.InputState{KeepNewOre: true})
+	if _, pending := cs.GetPendingOre(); pending || player.OreInventory[entities.OreCopper] != 10 {
+		t.Errorf("Keep should store the ore once there is room, got %d copper (pending=%v)",
+			player.OreInventory[entities.OreCopper], pending)
+	}
+}
+
+func TestCargoSystem_PromptDiscard(t *testing.T) {
+	cs := NewCargoSystem()
+	player := fullHoldPlayer()
+	player.CargoPolicy = entities.CargoPolicyPrompt
+
+	cs.CollectOre(player, entities.OreGold, 100, 200)
+	cs.ProcessCargo(player, input.InputState{DiscardNewOre: true})
+
+	if player.OreInventory[entities.OreGold] != 0 {
+		t.Error("Discarded ore should not enter the hold")
+	}
+	if dropped := cs.TakeDroppedOre(); len(dropped) != 1 || dropped[0].OreType != entities.OreGold {
+		t.Errorf("Discarded gold should be dropped, got %+v", dropped)
+	}
+}
+
+func TestCargoSystem_JettisonOneUnit(t *testing.T) {
+	cs := NewCargoSystem()
+	player := entities.NewPlayer(0, 0)
+	player.AddOre(entities.OreIron)
+	player.AddOre(entities.OreIron)
+
+	cs.ProcessCargo(player, input.InputState{JettisonOre: int(entities.OreIron) + 1})
+
+	if player.OreInventory[entities.OreIron] != 1 {
+		t.Errorf("Expected 1 iron left after jettison, got %d", player.OreInventory[entities.OreIron])
+	}
+	if dropped := cs.TakeDroppedOre(); len(dropped) != 1 || dropped[0].OreType != entities.OreIron {
+		t.Errorf("Expected one jettisoned iron, got %+v", dropped)
+	}
+
+	// Jettisoning an ore type that isn't held does nothing
+	cs.ProcessCargo(player, input.InputState{JettisonOre: int(entities.OreDiamond) + 1})
+	if len(cs.TakeDroppedOre()) != 0 {
+		t.Error("Nothing should be dropped for an ore type not held")
+	}
+}
+
+func TestCargoSystem_CyclePolicy(t *testing.T) {
+	cs := NewCargoSystem()
+	player := entities.NewPlayer(0, 0)
+
+	cs.ProcessCargo(player, input.InputState{CycleCargoPolicy: true})
+	if player.CargoPolicy != entities.CargoPolicyPrompt {
+		t.Errorf("Expected Prompt policy after one cycle, got %v", player.CargoPolicy)
+	}
+
+	cs.ProcessCargo(player, input.InputState{CycleCargoPolicy: true})
+	cs.ProcessCargo(player, input.InputState{CycleCargoPolicy: true})
+	if player.CargoPolicy != entities.CargoPolicyDropLeastValuable {
+		t.Errorf("Policy should wrap around, got %v", player.CargoPolicy)
+	}
+}
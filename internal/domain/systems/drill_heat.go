@@ -0,0 +1,62 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/physics"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// Drill heat runs from 0 (cool) to 1 (overheated), in share of the gauge per second
+const (
+	DrillHeatRate              = 0.05  // Heat per second drilling surface dirt with the base drill (20s to overheat)
+	DrillHeatTemperatureScale  = 100.0 // °C of ambient temperature that add one base rate of heat
+	DrillHeatTierRelief        = 0.2   // Each drill tier divides heat build-up by a further 20% of the base rate
+	DrillCoolRate              = 0.1   // Heat shed per second while the drill is idle, before the shield bonus
+	DrillCoolShieldScale       = 100.0 // Heat resistance that adds one base rate of cooling
+	DrillHeatResume            = 0.5   // An overheated drill runs normally again once cooled to this level
+	OverheatedDrillSpeedFactor = 0.1   // Share of the damage rate an overheated drill still deals
+)
+
+// tileHeatRate returns the heat per second of drilling the tile at grid coordinates
+// Deeper tiles (hotter ambient temperature) and harder ores heat the drill faster;
+// better drills heat up slower
+func tileHeatRate(player *entities.Player, w *world.World, tileGridX, tileGridY int) float32 {
+	temperature := physics.CalculateTemperature(w.GetConfig(), float32(tileGridY)*world.TileSize)
+	ambient := 1 + max(temperature, 0)/DrillHeatTemperatureScale
+
+	hardness := float32(1)
+	if tile := w.GetTileAtGrid(tileGridX, tileGridY); tile != nil && tile.Type == entities.TileTypeOre {
+		if oreHardness, ok := entities.OreHardness[tile.OreType]; ok {
+			hardness = oreHardness
+		}
+	}
+
+	relief := 1 + DrillHeatTierRelief*float32(player.Drill().Tier())
+
+	return DrillHeatRate * ambient * hardness / relief
+}
+
+// heatDrill adds heat for dt seconds of drilling; the drill overheats when the gauge fills
+func heatDrill(player *entities.Player, heatRate, dt float32) {
+	player.DrillHeat = min(player.DrillHeat+heatRate*dt, 1)
+	if player.DrillHeat >= 1 {
+		player.DrillOverheated = true
+	}
+}
+
+// coolDrill sheds heat for dt seconds of idling; a better heat shield cools faster
+func coolDrill(player *entities.Player, dt float32) {
+	coolRate := DrillCoolRate * (1 + player.HeatResistance()/DrillCoolShieldScale)
+	player.DrillHeat = max(player.DrillHeat-coolRate*dt, 0)
+	if player.DrillHeat <= DrillHeatResume {
+		player.DrillOverheated = false
+	}
+}
+
+// drillSpeedFactor is the share of its damage rate the drill currently deals
+func drillSpeedFactor(player *entities.Player) float32 {
+	if player.DrillOverheated {
+		return OverheatedDrillSpeedFactor
+	}
+	return 1
+}
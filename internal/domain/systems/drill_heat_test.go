@@ -0,0 +1,142 @@
+package systems
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// placeDirtBelow puts a dirt tile under the player and returns its grid coordinates
+func placeDirtBelow(w *world.World, player *entities.Player) (int, int) {
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+	return tileX, tileY
+}
+
+func TestDrillHeat_BuildsWhileDrilling(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+	tileX, tileY := placeDirtBelow(w, player)
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01) // Starts the drill
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.5)
+
+	expected := tileHeatRate(player, w, tileX, tileY) * 0.5
+	if math.Abs(float64(player.DrillHeat-expected)) > 0.0001 {
+		t.Errorf("Expected drill heat %.4f after 0.5s, got %.4f", expected, player.DrillHeat)
+	}
+	if player.DrillOverheated {
+		t.Error("Drill should not overheat on a single surface tile")
+	}
+}
+
+func TestDrillHeat_OverheatedDrillBarelyBites(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	player.DrillHeat = 1
+	player.DrillOverheated = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+	tileX, tileY := placeDirtBelow(w, player)
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.5)
+
+	// Surface dirt has 1 HP and a base drill removes 1 HP/s; overheated it removes a tenth of that
+	expected := w.TileMaxHitPoints(tileX, tileY) - 0.5*OverheatedDrillSpeedFactor
+	if math.Abs(float64(w.TileHitPoints(tileX, tileY)-expected)) > 0.0001 {
+		t.Errorf("Expected %.4f HP left on the tile, got %.4f", expected, w.TileHitPoints(tileX, tileY))
+	}
+	if player.DrillHeat != 1 {
+		t.Errorf("Heat should stay capped at 1, got %f", player.DrillHeat)
+	}
+}
+
+func TestDrillHeat_OverheatedDrillStretchesTimeRemaining(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+	placeDirtBelow(w, player)
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+	coolEstimate := drillingSystem.GetTimeRemaining(player)
+
+	player.DrillHeat = 1
+	player.DrillOverheated = true
+	expected := coolEstimate / OverheatedDrillSpeedFactor
+	if got := drillingSystem.GetTimeRemaining(player); math.Abs(float64(got-expected)) > 0.0001 {
+		t.Errorf("Overheated drill should report %.4fs remaining, got %.4fs", expected, got)
+	}
+}
+
+func TestDrillHeat_CoolsWhileIdleUntilResume(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.DrillHeat = 1
+	player.DrillOverheated = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	coolRate := DrillCoolRate * (1 + player.HeatResistance()/DrillCoolShieldScale)
+	drillingSystem.ProcessDrilling(player, input.InputState{}, 1.0)
+
+	if math.Abs(float64(player.DrillHeat-(1-coolRate))) > 0.0001 {
+		t.Errorf("Expected heat %.4f after 1s idle, got %.4f", 1-coolRate, player.DrillHeat)
+	}
+	if !player.DrillOverheated {
+		t.Error("Drill should stay overheated above the resume level")
+	}
+
+	drillingSystem.ProcessDrilling(player, input.InputState{}, (1-DrillHeatResume)/coolRate)
+	if player.DrillOverheated {
+		t.Errorf("Drill should recover once cooled to %.2f, heat is %.4f", DrillHeatResume, player.DrillHeat)
+	}
+}
+
+func TestDrillHeat_DepthAndOreHeatFasterBetterDrillSlower(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+
+	w.GetTileAtGrid(1, 20) // Generate the chunks first so they don't overwrite the tiles below
+	w.GetTileAtGrid(1, 800)
+	w.SetTile(1, 20, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(1, 800, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(2, 800, entities.NewOreTile(entities.OreDiamond))
+
+	shallow := tileHeatRate(player, w, 1, 20)
+	deep := tileHeatRate(player, w, 1, 800)
+	diamond := tileHeatRate(player, w, 2, 800)
+
+	if deep <= shallow {
+		t.Errorf("Deeper dirt should heat faster: shallow %.4f, deep %.4f", shallow, deep)
+	}
+	if diamond != deep*entities.OreHardness[entities.OreDiamond] {
+		t.Errorf("Diamond should heat %.1fx faster than dirt, got %.4f vs %.4f", entities.OreHardness[entities.OreDiamond], diamond, deep)
+	}
+
+	player.Loadout[entities.SlotDrill] = entities.NewComponent(entities.SlotDrill, 5)
+	if upgraded := tileHeatRate(player, w, 1, 800); upgraded >= deep {
+		t.Errorf("A better drill should heat slower: base %.4f, Mk5 %.4f", deep, upgraded)
+	}
+}
+
+func TestDrillHeat_BetterHeatShieldCoolsFaster(t *testing.T) {
+	base := entities.NewPlayer(0, 0)
+	base.DrillHeat = 1
+	shielded := entities.NewPlayer(0, 0)
+	shielded.DrillHeat = 1
+	shielded.Loadout[entities.SlotHeatShield] = entities.NewComponent(entities.SlotHeatShield, 5)
+
+	coolDrill(base, 1.0)
+	coolDrill(shielded, 1.0)
+
+	if shielded.DrillHeat >= base.DrillHeat {
+		t.Errorf("Mk5 heat shield should cool faster: base %.4f, Mk5 %.4f", base.DrillHeat, shielded.DrillHeat)
+	}
+}
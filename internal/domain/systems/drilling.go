@@ -3,15 +3,13 @@ package systems
 import (
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
-	"github.com/Kishlin/drill-game/internal/domain/physics"
 	"github.com/Kishlin/drill-game/internal/domain/types"
 	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
 const (
-	minDrillingDuration   = 1.0  // seconds (at ground level)
-	maxDrillingDuration   = 24.0 // seconds (at max depth)
-	floorDrillingDuration = 0.5  // seconds (absolute minimum, safety clamp)
+	floorDrillingDuration = 0.5 // seconds to drill a full tile (absolute minimum, safety clamp)
+	ceilingContactMargin  = 1.0 // pixels between player top and ceiling that still count as touching
 )
 
 type DrillDirection int
@@ -20,6 +18,9 @@ const (
 	DrillDown DrillDirection = iota
 	DrillLeft
 	DrillRight
+	DrillUp
+	DrillDownLeft
+	DrillDownRight
 )
 
 type DrillingAnimation struct {
@@ -31,21 +32,27 @@ type DrillingAnimation struct {
 	TargetY     float32 // Depends on direction
 	TargetGridX int
 	TargetGridY int
-	Elapsed     float32
-	Duration    float32
-	Tile        *entities.Tile // For ore collection on completion
+	DamageRate  float32 // Tile hit points removed per second
+	HeatRate    float32 // Drill heat gained per second (depth, ore hardness, drill tier)
+	Hovering    bool    // Started mid-air: the engine holds altitude (extra fuel)
+
+	InitialProgress float32 // Tile damage ratio (0-1) when this drill started
 }
 
 type DrillingSystem struct {
 	world     *world.World
+	cargo     *CargoSystem
 	animation DrillingAnimation
 }
 
-func NewDrillingSystem(w *world.World) *DrillingSystem {
-	return &DrillingSystem{world: w}
+func NewDrillingSystem(w *world.World, cargo *CargoSystem) *DrillingSystem {
+	return &DrillingSystem{
+		world: w,
+		cargo: cargo,
+	}
 }
 
-// ProcessDrilling handles vertical and horizontal drilling with animation
+// ProcessDrilling handles downward, upward, horizontal and diagonal drilling with animation
 func (ds *DrillingSystem) ProcessDrilling(
 	player *entities.Player,
 	inputState input.InputState,
@@ -53,19 +60,47 @@ func (ds *DrillingSystem) ProcessDrilling(
 ) {
 	// Update animation if in progress
 	if ds.animation.Active {
-		ds.updateDrillAnimation(player, dt)
+		if inputState.CancelDrill {
+			ds.cancelDrillAnimation(player)
+			return
+		}
+		ds.updateDrillAnimation(player, inputState, dt)
 		return
 	}
 
+	// The drill only cools while idle
+	coolDrill(player, dt)
+
+	// Stunned players can't start a drill (a running one still finishes)
+	if player.Status.Modifiers().Stunned {
+		return
+	}
+
+	// Handle diagonal drilling (S/Down + Left/Right, unlocked by drill upgrades)
+	if inputState.Drill && (inputState.Left || inputState.Right) && player.OnGround && player.Drill().CanDrillDiagonally() {
+		if ds.processDiagonalDrilling(player, inputState) {
+			return
+		}
+	}
+
 	// Handle vertical drilling (S/Down key)
 	if inputState.Drill && player.OnGround {
 		ds.processVerticalDrilling(player)
 		return
 	}
 
-	// Handle horizontal drilling (Left/Right when grounded)
-	if player.OnGround {
-		ds.processHorizontalDrilling(player, inputState)
+	// Handle upward drilling (Up while hovering against a ceiling)
+	if inputState.Up {
+		ds.processUpwardDrilling(player)
+		if ds.animation.Active {
+			return
+		}
+	}
+
+	// Handle horizontal drilling (Left/Right when grounded, or hovering with Up held)
+	hovering := !player.OnGround && inputState.Up && player.Engine().CanHoverDrill()
+	if player.OnGround || hovering {
+		ds.processHorizontalDrilling(player, inputState, hovering)
 	}
 }
 
@@ -94,13 +129,90 @@ func (ds *DrillingSystem) processVerticalDrilling(player *entities.Player) {
 	targetY := tileBottomY - player.AABB.Height
 
 	// Start animation
-	ds.startDrillAnimation(player, DrillDown, tileGridX, tileGridY, targetX, targetY, tile)
+	ds.startDrillAnimation(player, DrillDown, tileGridX, tileGridY, targetX, targetY)
+}
+
+// processUpwardDrilling handles drilling into the ceiling (starts animation)
+// The player must be pressed against the ceiling, which is where flying up leaves them
+func (ds *DrillingSystem) processUpwardDrilling(player *entities.Player) {
+	playerCenterX := player.AABB.X + player.AABB.Width/2
+	playerTopY := player.AABB.Y
+
+	// Check tile directly above player
+	tile := ds.world.GetTileAt(playerCenterX, playerTopY-1)
+	if tile == nil || !tile.IsDrillable() {
+		return
+	}
+
+	tileGridX := int(playerCenterX / world.TileSize)
+	tileGridY := int((playerTopY - 1) / world.TileSize)
+
+	// Only drill when touching the ceiling, not from across a gap
+	tileBottomY := float32(tileGridY+1) * world.TileSize
+	if playerTopY-tileBottomY > ceilingContactMargin {
+		return
+	}
+
+	// Calculate target position
+	tileCenterX := float32(tileGridX)*world.TileSize + world.TileSize/2
+	targetX := tileCenterX - player.AABB.Width/2
+
+	// Target Y: player top edge aligns with tile top edge
+	targetY := float32(tileGridY) * world.TileSize
+
+	ds.startDrillAnimation(player, DrillUp, tileGridX, tileGridY, targetX, targetY)
+}
+
+// processDiagonalDrilling handles drilling the tile below-left or below-right (starts animation)
+// Returns false when no drillable tile is there or a solid side tile blocks the way,
+// so plain downward drilling can take over
+func (ds *DrillingSystem) processDiagonalDrilling(
+	player *entities.Player,
+	inputState input.InputState,
+) bool {
+	playerCenterX := player.AABB.X + player.AABB.Width/2
+	playerBottomY := player.AABB.Y + player.AABB.Height
+
+	direction := DrillDownRight
+	offsetX := 1
+	if inputState.Left {
+		direction = DrillDownLeft
+		offsetX = -1
+	}
+
+	tileGridX := int(playerCenterX/world.TileSize) + offsetX
+	tileGridY := int(playerBottomY / world.TileSize)
+
+	tile := ds.world.GetTileAtGrid(tileGridX, tileGridY)
+	if tile == nil || !tile.IsDrillable() {
+		return false
+	}
+
+	// The animation slides the vehicle sideways through the rows above the target, which aren't drilled
+	for row := int(player.AABB.Y / world.TileSize); row < tileGridY; row++ {
+		if side := ds.world.GetTileAtGrid(tileGridX, row); side != nil && side.IsSolid() {
+			return false
+		}
+	}
+
+	// Target: centered in the diagonal tile, bottom edges aligned (same as downward drilling)
+	tileCenterX := float32(tileGridX)*world.TileSize + world.TileSize/2
+	targetX := tileCenterX - player.AABB.Width/2
+
+	tileBottomY := float32(tileGridY+1) * world.TileSize
+	targetY := tileBottomY - player.AABB.Height
+
+	ds.startDrillAnimation(player, direction, tileGridX, tileGridY, targetX, targetY)
+	return true
 }
 
 // processHorizontalDrilling handles left/right drilling (starts animation)
+// When hovering, the player may straddle two tile rows, so the target is aligned
+// to the bottom of the drilled tile's row instead of keeping the current height
 func (ds *DrillingSystem) processHorizontalDrilling(
 	player *entities.Player,
 	inputState input.InputState,
+	hovering bool,
 ) {
 	playerCenterY := player.AABB.Y + player.AABB.Height/2
 
@@ -116,10 +228,10 @@ func (ds *DrillingSystem) processHorizontalDrilling(
 			tileCenterX := float32(tileGridX)*world.TileSize + world.TileSize/2
 			targetX := tileCenterX - player.AABB.Width/2
 
-			// Y stays at current ground level
-			targetY := player.AABB.Y
+			targetY := ds.horizontalTargetY(player, tileGridY, hovering)
 
-			ds.startDrillAnimation(player, DrillLeft, tileGridX, tileGridY, targetX, targetY, tile)
+			ds.startDrillAnimation(player, DrillLeft, tileGridX, tileGridY, targetX, targetY)
+			ds.setHovering(player, hovering)
 			return
 		}
 	}
@@ -136,34 +248,40 @@ func (ds *DrillingSystem) processHorizontalDrilling(
 			tileCenterX := float32(tileGridX)*world.TileSize + world.TileSize/2
 			targetX := tileCenterX - player.AABB.Width/2
 
-			// Y stays at current ground level
-			targetY := player.AABB.Y
+			targetY := ds.horizontalTargetY(player, tileGridY, hovering)
 
-			ds.startDrillAnimation(player, DrillRight, tileGridX, tileGridY, targetX, targetY, tile)
+			ds.startDrillAnimation(player, DrillRight, tileGridX, tileGridY, targetX, targetY)
+			ds.setHovering(player, hovering)
 			return
 		}
 	}
 }
 
+// horizontalTargetY keeps the current height on the ground, or fits the player
+// inside the drilled tile's row when hovering so it never overlaps the rows above or below
+func (ds *DrillingSystem) horizontalTargetY(player *entities.Player, tileGridY int, hovering bool) float32 {
+	if !hovering {
+		return player.AABB.Y // Y stays at current ground level
+	}
+	return float32(tileGridY+1)*world.TileSize - player.AABB.Height
+}
+
+// setHovering marks the current drill (and the player) as held up by the engine
+func (ds *DrillingSystem) setHovering(player *entities.Player, hovering bool) {
+	ds.animation.Hovering = hovering
+	player.IsHovering = hovering
+}
+
 func (ds *DrillingSystem) startDrillAnimation(
 	player *entities.Player,
 	direction DrillDirection,
 	tileGridX, tileGridY int,
 	targetX, targetY float32,
-	tile *entities.Tile,
 ) {
-	// Calculate tile Y position and base drilling duration
 	tileY := float32(tileGridY) * world.TileSize
-	baseDuration := ds.calculateDrillingDuration(tileY, tile)
 
 	// Calculate depth factor (0 at ground level, 1 at max depth)
-	groundLevel := ds.world.GroundLevel
-	maxDepth := physics.MaxUndergroundY - groundLevel
-	depthBelowGround := tileY - groundLevel
-	depthFactor := depthBelowGround / maxDepth
-	if depthFactor < 0 {
-		depthFactor = 0
-	}
+	depthFactor := ds.world.GetConfig().NormalizedDepth(tileY)
 	if depthFactor > 1 {
 		depthFactor = 1
 	}
@@ -171,115 +289,173 @@ func (ds *DrillingSystem) startDrillAnimation(
 	// Apply depth-scaled drill divisor
 	// At surface (depthFactor=0): only 10% of upgrade applies
 	// At max depth (depthFactor=1): 100% of upgrade applies
-	drillSpeed := player.Drill.DrillSpeed()
-	effectiveDivisor := 1 + (drillSpeed-1)*(0.1+0.9*depthFactor)
-	duration := baseDuration / effectiveDivisor
+	drillSpeed := player.DrillSpeed() // Includes an active drill boost
+	damageRate := 1 + (drillSpeed-1)*(0.1+0.9*depthFactor)
 
-	// Apply floor clamp
-	if duration < floorDrillingDuration {
-		duration = floorDrillingDuration
+	// Apply floor clamp: no tile, however soft, breaks faster than floorDrillingDuration
+	maxHitPoints := ds.world.TileMaxHitPoints(tileGridX, tileGridY)
+	if maxRate := maxHitPoints / floorDrillingDuration; damageRate > maxRate {
+		damageRate = maxRate
 	}
 
 	ds.animation = DrillingAnimation{
-		Active:      true,
-		Direction:   direction,
-		StartX:      player.AABB.X,
-		StartY:      player.AABB.Y,
-		TargetX:     targetX,
-		TargetY:     targetY,
-		TargetGridX: tileGridX,
-		TargetGridY: tileGridY,
-		Elapsed:     0,
-		Duration:    duration,
-		Tile:        tile,
+		Active:          true,
+		Direction:       direction,
+		StartX:          player.AABB.X,
+		StartY:          player.AABB.Y,
+		TargetX:         targetX,
+		TargetY:         targetY,
+		TargetGridX:     tileGridX,
+		TargetGridY:     tileGridY,
+		DamageRate:      damageRate,
+		HeatRate:        tileHeatRate(player, ds.world, tileGridX, tileGridY),
+		InitialProgress: ds.world.TileDamageRatio(tileGridX, tileGridY), // Resume earlier damage
 	}
 
 	player.IsDrilling = true
+	player.DrillHardness = maxHitPoints // Harder tiles burn more fuel
 
 	// Zero player velocity to prevent physics interference
 	player.Velocity = types.Vec2{}
 }
 
-func (ds *DrillingSystem) updateDrillAnimation(player *entities.Player, dt float32) {
-	ds.animation.Elapsed += dt
+// updateDrillAnimation wears the target tile down and moves the player along with the damage
+// The drill heats up as it works; once overheated it barely bites until it has cooled
+func (ds *DrillingSystem) updateDrillAnimation(player *entities.Player, inputState input.InputState, dt float32) {
+	gridX, gridY := ds.animation.TargetGridX, ds.animation.TargetGridY
 
-	// Calculate progress (0.0 to 1.0)
-	progress := ds.animation.Elapsed / ds.animation.Duration
-	if progress > 1.0 {
-		progress = 1.0
-	}
+	heatDrill(player, ds.animation.HeatRate, dt)
+	damage := ds.animation.DamageRate * drillSpeedFactor(player) * dt
 
-	// Lerp player position toward target
-	player.AABB.X = ds.animation.StartX + (ds.animation.TargetX-ds.animation.StartX)*progress
-	player.AABB.Y = ds.animation.StartY + (ds.animation.TargetY-ds.animation.StartY)*progress
+	dugTile, destroyed := ds.world.DamageTileAtGrid(gridX, gridY, damage)
+	if destroyed {
+		ds.finishDrillAnimation(player, inputState, dugTile)
+		return
+	}
 
-	// On completion
-	if progress >= 1.0 {
-		ds.finishDrillAnimation(player)
+	// The tile vanished some other way (e.g. a bomb): stop without collecting anything
+	if ds.world.GetTileAtGrid(gridX, gridY) == nil {
+		ds.finishDrillAnimation(player, inputState, nil)
+		return
 	}
+
+	// Lerp player position toward target over the damage dealt by this drill
+	progress := ds.world.TileDamageRatio(gridX, gridY)
+	moveProgress := (progress - ds.animation.InitialProgress) / (1 - ds.animation.InitialProgress)
+	player.AABB.X = ds.animation.StartX + (ds.animation.TargetX-ds.animation.StartX)*moveProgress
+	player.AABB.Y = ds.animation.StartY + (ds.animation.TargetY-ds.animation.StartY)*moveProgress
 }
 
-func (ds *DrillingSystem) finishDrillAnimation(player *entities.Player) {
-	// Remove tile via grid coordinates
-	if dugTile, success := ds.world.DrillTileAtGrid(ds.animation.TargetGridX, ds.animation.TargetGridY); success {
-		ds.collectOreIfPresent(player, dugTile)
-	}
+func (ds *DrillingSystem) finishDrillAnimation(player *entities.Player, inputState input.InputState, dugTile *entities.Tile) {
+	finished := ds.animation
+
+	// Snap to target: the final frame's damage may overshoot the tile's remaining hit points
+	player.AABB.X = finished.TargetX
+	player.AABB.Y = finished.TargetY
+
+	ds.collectOreIfPresent(player, dugTile, finished.TargetGridX, finished.TargetGridY)
 
 	// Reset animation state
 	ds.animation = DrillingAnimation{}
 
+	// Chain mode: keep drilling while the same direction is held
+	// IsDrilling stays true, so physics and interactions never run between chained tiles
+	if ds.chainNextTile(player, inputState, finished) {
+		ds.setHovering(player, finished.Hovering)
+		return
+	}
+
 	player.IsDrilling = false
+	player.IsHovering = false
+	player.DrillHardness = 0
 
 	// Zero player velocity to prevent physics residue
 	player.Velocity = types.Vec2{}
 }
 
-// collectOreIfPresent adds ore to player inventory if the dug tile is ore
-// Ore is lost if cargo is full
-func (ds *DrillingSystem) collectOreIfPresent(player *entities.Player, dugTile *entities.Tile) {
-	if dugTile != nil && dugTile.Type == entities.TileTypeOre {
-		player.AddOre(dugTile.OreType)
-		// If AddOre returns false (cargo full), ore is silently lost
-	}
-}
+// cancelDrillAnimation stops the current drill; damage already dealt stays on the tile in World
+// The player returns to where the drill started, which is known to be free of solid tiles
+func (ds *DrillingSystem) cancelDrillAnimation(player *entities.Player) {
+	player.AABB.X = ds.animation.StartX
+	player.AABB.Y = ds.animation.StartY
+
+	ds.animation = DrillingAnimation{}
 
-// calculateDrillingDuration computes the time to drill a tile based on depth and type
-func (ds *DrillingSystem) calculateDrillingDuration(tileY float32, tile *entities.Tile) float32 {
-	baseDuration := ds.calculateBaseDuration(tileY)
+	player.IsDrilling = false
+	player.IsHovering = false
+	player.DrillHardness = 0
+	player.Velocity = types.Vec2{}
+}
 
-	// Apply ore hardness multiplier if applicable
-	if tile.Type == entities.TileTypeOre {
-		hardness, ok := entities.OreHardness[tile.OreType]
-		if !ok {
-			hardness = 1.5 // Fallback for unknown ore types
-		}
-		return baseDuration * hardness
+// chainNextTile starts drilling the next tile along the finished drill's direction
+// when that direction is still held. Returns true if a new drill started
+func (ds *DrillingSystem) chainNextTile(
+	player *entities.Player,
+	inputState input.InputState,
+	finished DrillingAnimation,
+) bool {
+	var held bool
+	var stepX, stepY int
+
+	switch finished.Direction {
+	case DrillDown:
+		held, stepY = inputState.Drill, 1
+	case DrillUp:
+		held, stepY = inputState.Up, -1
+	case DrillLeft:
+		held, stepX = inputState.Left, -1
+	case DrillRight:
+		held, stepX = inputState.Right, 1
+	case DrillDownLeft:
+		held, stepX, stepY = inputState.Drill && inputState.Left, -1, 1
+	case DrillDownRight:
+		held, stepX, stepY = inputState.Drill && inputState.Right, 1, 1
 	}
 
-	return baseDuration
-}
+	if !held {
+		return false
+	}
 
-// calculateBaseDuration computes drilling time for dirt based on depth
-// Linear interpolation: 1 second at ground level, 30 seconds at max depth
-func (ds *DrillingSystem) calculateBaseDuration(tileY float32) float32 {
-	groundLevel := ds.world.GroundLevel
-	depthBelowGround := tileY - groundLevel
+	tileGridX := finished.TargetGridX + stepX
+	tileGridY := finished.TargetGridY + stepY
 
-	// Above ground: use minimum duration
-	if depthBelowGround <= 0 {
-		return minDrillingDuration
+	tile := ds.world.GetTileAtGrid(tileGridX, tileGridY)
+	if tile == nil || !tile.IsDrillable() {
+		return false
 	}
 
-	maxDepth := physics.MaxUndergroundY - groundLevel
-	normalizedDepth := depthBelowGround / maxDepth
+	// Center on the next tile; vertical alignment follows the direction
+	tileCenterX := float32(tileGridX)*world.TileSize + world.TileSize/2
+	targetX := tileCenterX - player.AABB.Width/2
 
-	// Clamp normalized depth to [0, 1] in case tile exceeds MaxUndergroundY
-	if normalizedDepth > 1.0 {
-		normalizedDepth = 1.0
+	targetY := player.AABB.Y // Horizontal drilling keeps the current height
+	switch finished.Direction {
+	case DrillDown, DrillDownLeft, DrillDownRight:
+		targetY = float32(tileGridY+1)*world.TileSize - player.AABB.Height
+	case DrillUp:
+		targetY = float32(tileGridY) * world.TileSize
 	}
 
-	// Linear interpolation
-	duration := minDrillingDuration + normalizedDepth*(maxDrillingDuration-minDrillingDuration)
+	ds.startDrillAnimation(player, finished.Direction, tileGridX, tileGridY, targetX, targetY)
+	return true
+}
+
+// GetTimeRemaining returns seconds left on the current drill at the player's current drilling speed (0 when idle)
+// An overheated drill runs slower, so the estimate grows with it
+func (ds *DrillingSystem) GetTimeRemaining(player *entities.Player) float32 {
+	if !ds.animation.Active {
+		return 0
+	}
+	hitPoints := ds.world.TileHitPoints(ds.animation.TargetGridX, ds.animation.TargetGridY)
+	return hitPoints / (ds.animation.DamageRate * drillSpeedFactor(player))
+}
 
-	return duration
+// collectOreIfPresent hands dug ore to the cargo system
+// When the hold is full, the player's cargo policy decides what is kept
+func (ds *DrillingSystem) collectOreIfPresent(player *entities.Player, dugTile *entities.Tile, gridX, gridY int) {
+	if dugTile != nil && dugTile.Type == entities.TileTypeOre {
+		tileCenterX := float32(gridX)*world.TileSize + world.TileSize/2
+		tileCenterY := float32(gridY)*world.TileSize + world.TileSize/2
+		ds.cargo.CollectOre(player, dugTile.OreType, tileCenterX, tileCenterY)
+	}
 }
@@ -12,7 +12,7 @@ func TestVerticalDrilling_StartsAnimation(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place dirt tile below player
 	playerCenterX := player.AABB.X + player.AABB.Width/2
@@ -32,16 +32,37 @@ func TestVerticalDrilling_StartsAnimation(t *testing.T) {
 	if !drillingSystem.animation.Active {
 		t.Error("Internal animation state should be active")
 	}
-	if drillingSystem.animation.Duration <= 0 {
+	if drillingSystem.GetTimeRemaining(player) <= 0 {
 		t.Error("Animation duration should be positive")
 	}
 }
 
+func TestVerticalDrilling_RecordsTileHardnessForFuel(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+	if player.DrillHardness != w.TileMaxHitPoints(tileX, tileY) {
+		t.Errorf("Expected drill hardness %f, got %f", w.TileMaxHitPoints(tileX, tileY), player.DrillHardness)
+	}
+
+	drillingSystem.ProcessDrilling(player, input.InputState{CancelDrill: true}, 0.01)
+	if player.DrillHardness != 0 {
+		t.Errorf("Hardness should reset once drilling stops, got %f", player.DrillHardness)
+	}
+}
+
 func TestVerticalDrilling_DirtDuration(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place dirt at ground level
 	playerCenterX := player.AABB.X + player.AABB.Width/2
@@ -54,8 +75,8 @@ func TestVerticalDrilling_DirtDuration(t *testing.T) {
 	drillingSystem.ProcessDrilling(player, inputState, 0.01)
 
 	// Dirt at ground level should take 1.0 seconds (with base drill, no speedup)
-	if drillingSystem.animation.Duration != 1.0 {
-		t.Errorf("Dirt at ground level should take 1.0s, got %f", drillingSystem.animation.Duration)
+	if drillingSystem.GetTimeRemaining(player) != 1.0 {
+		t.Errorf("Dirt at ground level should take 1.0s, got %f", drillingSystem.GetTimeRemaining(player))
 	}
 }
 
@@ -77,7 +98,7 @@ func TestOreDrilling_AppliesHardnessMultiplier(t *testing.T) {
 		w2 := world.NewWorld(7680, 64000, 640, 42)
 		player2 := entities.NewPlayer(100, 500)
 		player2.OnGround = true
-		ds := NewDrillingSystem(w2)
+		ds := NewDrillingSystem(w2, NewCargoSystem())
 
 		playerCenterX := player2.AABB.X + player2.AABB.Width/2
 		playerBottomY := player2.AABB.Y + player2.AABB.Height
@@ -90,35 +111,9 @@ func TestOreDrilling_AppliesHardnessMultiplier(t *testing.T) {
 
 		// Use tolerance-based comparison for floats
 		const tolerance = 0.001
-		if ds.animation.Duration < test.expected-tolerance || ds.animation.Duration > test.expected+tolerance {
+		if ds.GetTimeRemaining(player2) < test.expected-tolerance || ds.GetTimeRemaining(player2) > test.expected+tolerance {
 			t.Errorf("Ore %v at ground level: expected ~%f seconds, got %f",
-				test.oreType, test.expected, ds.animation.Duration)
-		}
-	}
-}
-
-func TestDrilling_DepthAffectsDuration(t *testing.T) {
-	w := world.NewWorld(7680, 64000, 640, 42)
-	drillingSystem := NewDrillingSystem(w)
-
-	depthTests := []struct {
-		tileGridY int
-		minExpect float32
-		maxExpect float32
-	}{
-		{10, 0.9, 1.1},       // Near ground (Y=640): ~1.0s
-		{500, 12.0, 13.0},    // Mid-depth (Y=32000): ~12.4s
-		{990, 23.5, 24.5},    // Deep (Y=63360): ~24s
-	}
-
-	for _, test := range depthTests {
-		tileY := float32(test.tileGridY) * world.TileSize
-		tile := entities.NewTile(entities.TileTypeDirt)
-		duration := drillingSystem.calculateDrillingDuration(tileY, tile)
-
-		if duration < test.minExpect || duration > test.maxExpect {
-			t.Errorf("Grid Y=%d (pixel Y=%f): expected ~[%f, %f], got %f",
-				test.tileGridY, tileY, test.minExpect, test.maxExpect, duration)
+				test.oreType, test.expected, ds.GetTimeRemaining(player2))
 		}
 	}
 }
@@ -127,7 +122,7 @@ func TestHorizontalDrilling_CollectsOre(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place ore tile to the left
 	playerCenterY := player.AABB.Y + player.AABB.Height/2
@@ -144,12 +139,12 @@ func TestHorizontalDrilling_CollectsOre(t *testing.T) {
 	}
 
 	// Verify animation duration is correct for diamond (1.0 * 3.0 = 3.0)
-	if drillingSystem.animation.Duration != 3.0 {
-		t.Errorf("Diamond ore should take 3.0s, got %f", drillingSystem.animation.Duration)
+	if drillingSystem.GetTimeRemaining(player) != 3.0 {
+		t.Errorf("Diamond ore should take 3.0s, got %f", drillingSystem.GetTimeRemaining(player))
 	}
 
 	// Complete animation
-	dt := drillingSystem.animation.Duration + 0.01
+	dt := drillingSystem.GetTimeRemaining(player) + 0.01
 	drillingSystem.ProcessDrilling(player, inputState, dt)
 
 	// Should collect diamond
@@ -167,7 +162,7 @@ func TestDrilling_DoesNotStartOnNonDrillableTile(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place empty tile below player (no tile at all)
 	// This should prevent drilling from starting
@@ -184,7 +179,7 @@ func TestDrilling_AnimationProgress(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place ore to the right
 	playerCenterY := player.AABB.Y + player.AABB.Height/2
@@ -202,7 +197,7 @@ func TestDrilling_AnimationProgress(t *testing.T) {
 		t.Error("Drilling animation should be active")
 	}
 
-	duration := drillingSystem.animation.Duration
+	duration := drillingSystem.GetTimeRemaining(player)
 
 	// Advance animation halfway
 	drillingSystem.ProcessDrilling(player, inputState, duration/2)
@@ -226,7 +221,7 @@ func TestDrilling_TileRemovedOnCompletion(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place gold ore below player
 	playerCenterX := player.AABB.X + player.AABB.Width/2
@@ -244,7 +239,7 @@ func TestDrilling_TileRemovedOnCompletion(t *testing.T) {
 	// Start and complete drilling
 	inputState := input.InputState{Drill: true}
 	drillingSystem.ProcessDrilling(player, inputState, 0.01)
-	dt := drillingSystem.animation.Duration + 0.01
+	dt := drillingSystem.GetTimeRemaining(player) + 0.01
 	drillingSystem.ProcessDrilling(player, inputState, dt)
 
 	// Tile should be removed
@@ -258,7 +253,7 @@ func TestDrilling_DoesNotCollectDirt(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place dirt below player
 	playerCenterX := player.AABB.X + player.AABB.Width/2
@@ -276,7 +271,7 @@ func TestDrilling_DoesNotCollectDirt(t *testing.T) {
 	// Start and complete drilling
 	inputState := input.InputState{Drill: true}
 	drillingSystem.ProcessDrilling(player, inputState, 0.01)
-	dt := drillingSystem.animation.Duration + 0.01
+	dt := drillingSystem.GetTimeRemaining(player) + 0.01
 	drillingSystem.ProcessDrilling(player, inputState, dt)
 
 	// Check inventory - should not have changed (dirt not collected)
@@ -299,7 +294,7 @@ func TestDrilling_SkipsInputWhileAnimating(t *testing.T) {
 	w := world.NewWorld(7680, 64000, 640, 42)
 	player := entities.NewPlayer(100, 500)
 	player.OnGround = true
-	drillingSystem := NewDrillingSystem(w)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
 
 	// Place ore below and to the right
 	playerCenterX := player.AABB.X + player.AABB.Width/2
@@ -326,3 +321,276 @@ func TestDrilling_SkipsInputWhileAnimating(t *testing.T) {
 		t.Error("Direction should remain DrillDown while animation is active")
 	}
 }
+
+func TestUpwardDrilling_StartsWhenTouchingCeiling(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	// Player top flush with the bottom of tile row 7 (Y=512)
+	player := entities.NewPlayer(100, 512)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	w.SetTile(tileX, 7, entities.NewTile(entities.TileTypeDirt))
+
+	inputState := input.InputState{Up: true}
+	drillingSystem.ProcessDrilling(player, inputState, 0.01)
+
+	if !player.IsDrilling {
+		t.Fatal("Upward drilling should start when pressing Up against a ceiling")
+	}
+	if drillingSystem.animation.Direction != DrillUp {
+		t.Errorf("Expected DrillUp direction, got %v", drillingSystem.animation.Direction)
+	}
+	if drillingSystem.animation.TargetGridX != tileX || drillingSystem.animation.TargetGridY != 7 {
+		t.Errorf("Expected target tile (%d, 7), got (%d, %d)", tileX, drillingSystem.animation.TargetGridX, drillingSystem.animation.TargetGridY)
+	}
+
+	// Player top should end aligned with the drilled tile's top
+	if drillingSystem.animation.TargetY != 7*world.TileSize {
+		t.Errorf("Expected target Y %d, got %f", 7*world.TileSize, drillingSystem.animation.TargetY)
+	}
+
+	drillingSystem.ProcessDrilling(player, inputState, drillingSystem.GetTimeRemaining(player)+0.01)
+
+	if w.GetTileAtGrid(tileX, 7) != nil {
+		t.Error("Ceiling tile should be removed after upward drilling")
+	}
+	if player.AABB.Y != 7*world.TileSize {
+		t.Errorf("Player should end inside the drilled tile, got Y=%f", player.AABB.Y)
+	}
+}
+
+func TestUpwardDrilling_RequiresCeilingContact(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	// Player hovering 10 pixels below the ceiling
+	player := entities.NewPlayer(100, 522)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	w.SetTile(tileX, 7, entities.NewTile(entities.TileTypeDirt))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Up: true}, 0.01)
+
+	if player.IsDrilling {
+		t.Error("Upward drilling should not start across a gap")
+	}
+}
+
+func TestDiagonalDrilling_LockedWithBaseDrill(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(tileX+1, tileY, entities.NewTile(entities.TileTypeDirt))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true, Right: true}, 0.01)
+
+	if drillingSystem.animation.Direction != DrillDown {
+		t.Errorf("Base drill should fall back to drilling down, got %v", drillingSystem.animation.Direction)
+	}
+	if drillingSystem.animation.TargetGridX != tileX {
+		t.Errorf("Expected target column %d, got %d", tileX, drillingSystem.animation.TargetGridX)
+	}
+}
+
+func TestDiagonalDrilling_BlockedBySolidSideTile(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	player := entities.NewPlayer(100, 640-entities.PlayerHeight)
+	player.OnGround = true
+	player.Loadout[entities.SlotDrill] = entities.NewComponent(entities.SlotDrill, 3)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	// The tile beside the player sits between it and the diagonal target below
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.GetTileAtGrid(tileX+1, tileY-1)
+	w.SetTile(tileX+1, tileY-1, entities.NewTile(entities.TileTypeDirt))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true, Right: true}, 0.01)
+
+	if drillingSystem.animation.Direction != DrillDown {
+		t.Errorf("Solid side tile should block the diagonal and fall back to drilling down, got %v",
+			drillingSystem.animation.Direction)
+	}
+	if drillingSystem.animation.TargetGridX != tileX {
+		t.Errorf("Expected target column %d, got %d", tileX, drillingSystem.animation.TargetGridX)
+	}
+}
+
+func TestDiagonalDrilling_TargetsTileBelowSide(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	player.Loadout[entities.SlotDrill] = entities.NewComponent(entities.SlotDrill, 3)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX-1, tileY, entities.NewOreTile(entities.OreCopper))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true, Left: true}, 0.01)
+
+	if drillingSystem.animation.Direction != DrillDownLeft {
+		t.Fatalf("Expected DrillDownLeft direction, got %v", drillingSystem.animation.Direction)
+	}
+
+	// Target: centered in the diagonal tile with bottom edges aligned
+	expectedX := float32(tileX-1)*world.TileSize + world.TileSize/2 - player.AABB.Width/2
+	expectedY := float32(tileY+1)*world.TileSize - player.AABB.Height
+	if drillingSystem.animation.TargetX != expectedX || drillingSystem.animation.TargetY != expectedY {
+		t.Errorf("Expected target (%f, %f), got (%f, %f)", expectedX, expectedY,
+			drillingSystem.animation.TargetX, drillingSystem.animation.TargetY)
+	}
+
+	drillingSystem.ProcessDrilling(player, input.InputState{}, drillingSystem.GetTimeRemaining(player)+0.01)
+
+	if player.OreInventory[entities.OreCopper] != 1 {
+		t.Errorf("Expected 1 copper collected, got %d", player.OreInventory[entities.OreCopper])
+	}
+}
+
+func TestDrilling_CancelKeepsProgressAndRestoresPosition(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+
+	startX, startY := player.AABB.X, player.AABB.Y
+
+	// Dirt at ground level takes 1.0s: drill for 0.4s, then cancel
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.4)
+	drillingSystem.ProcessDrilling(player, input.InputState{CancelDrill: true}, 0.01)
+
+	if player.IsDrilling {
+		t.Fatal("Cancelling should stop the drill")
+	}
+	if player.AABB.X != startX || player.AABB.Y != startY {
+		t.Errorf("Player should return to start (%f, %f), got (%f, %f)", startX, startY, player.AABB.X, player.AABB.Y)
+	}
+	if w.GetTileAtGrid(tileX, tileY) == nil {
+		t.Error("Cancelled tile should not be removed")
+	}
+
+	progress := w.TileDamageRatio(tileX, tileY)
+	if progress < 0.39 || progress > 0.41 {
+		t.Errorf("Expected ~0.4 damage ratio kept on the tile, got %f", progress)
+	}
+
+	// Resuming needs only the remaining 0.6s
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+	drillingSystem.ProcessDrilling(player, input.InputState{}, 0.61)
+
+	if w.GetTileAtGrid(tileX, tileY) != nil {
+		t.Error("Resumed drill should finish using the saved progress")
+	}
+	if w.TileDamageRatio(tileX, tileY) != 0 {
+		t.Error("Damage should be cleared once the tile is drilled")
+	}
+}
+
+func TestDrilling_ChainsWhileDirectionHeld(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(tileX, tileY+1, entities.NewTile(entities.TileTypeDirt))
+
+	held := input.InputState{Drill: true}
+	drillingSystem.ProcessDrilling(player, held, 0.01)
+	drillingSystem.ProcessDrilling(player, held, drillingSystem.GetTimeRemaining(player)+0.01)
+
+	if !player.IsDrilling {
+		t.Fatal("Holding the direction should chain into the next tile without stopping")
+	}
+	if drillingSystem.animation.TargetGridY != tileY+1 {
+		t.Errorf("Chained drill should target row %d, got %d", tileY+1, drillingSystem.animation.TargetGridY)
+	}
+
+	// Releasing the key lets the chain end after the current tile
+	drillingSystem.ProcessDrilling(player, input.InputState{}, drillingSystem.GetTimeRemaining(player)+0.01)
+
+	if player.IsDrilling {
+		t.Error("Chain should stop once the direction is released")
+	}
+	if w.GetTileAtGrid(tileX, tileY+1) != nil {
+		t.Error("Chained tile should be removed")
+	}
+}
+
+func TestHoverDrilling_RequiresEngineTier(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(128, 500)
+	player.OnGround = false
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X - 1) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height/2) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Left: true, Up: true}, 0.01)
+
+	if player.IsDrilling {
+		t.Error("Base engine should not be able to drill sideways mid-air")
+	}
+}
+
+func TestHoverDrilling_AlignsToTileRowAndMarksHovering(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	// Airborne and straddling rows 7 and 8
+	player := entities.NewPlayer(128, 480)
+	player.OnGround = false
+	player.Loadout[entities.SlotEngine] = entities.NewComponent(entities.SlotEngine, 2)
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	tileX := int((player.AABB.X - 1) / world.TileSize)
+	tileY := int((player.AABB.Y + player.AABB.Height/2) / world.TileSize)
+	w.SetTile(tileX, tileY, entities.NewTile(entities.TileTypeDirt))
+
+	hover := input.InputState{Left: true, Up: true}
+	drillingSystem.ProcessDrilling(player, hover, 0.01)
+
+	if !player.IsDrilling || !player.IsHovering {
+		t.Fatal("Mk2 engine should hover-drill sideways while Up is held")
+	}
+
+	// Target keeps the player entirely inside the drilled tile's row
+	expectedY := float32(tileY+1)*world.TileSize - player.AABB.Height
+	if drillingSystem.animation.TargetY != expectedY {
+		t.Errorf("Expected target Y %f, got %f", expectedY, drillingSystem.animation.TargetY)
+	}
+
+	drillingSystem.ProcessDrilling(player, input.InputState{}, drillingSystem.GetTimeRemaining(player)+0.01)
+
+	if player.IsDrilling || player.IsHovering {
+		t.Error("Hover state should clear when the drill completes")
+	}
+	if player.AABB.Y != expectedY {
+		t.Errorf("Player should end aligned in the tile row, got Y=%f", player.AABB.Y)
+	}
+}
+
+func TestDrilling_StunnedCannotStartDrill(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(100, 500)
+	player.OnGround = true
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectStunned, 1, 0))
+	drillingSystem := NewDrillingSystem(w, NewCargoSystem())
+
+	drillingSystem.ProcessDrilling(player, input.InputState{Drill: true}, 0.01)
+
+	if player.IsDrilling {
+		t.Error("Stunned player should not start drilling")
+	}
+}
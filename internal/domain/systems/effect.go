@@ -0,0 +1,16 @@
+package systems
+
+import "github.com/Kishlin/drill-game/internal/domain/entities"
+
+// EffectSystem runs the player's status effects: tick callbacks, countdown and expiry
+type EffectSystem struct{}
+
+func NewEffectSystem() *EffectSystem {
+	return &EffectSystem{}
+}
+
+// ProcessEffects ticks active statuses and drops those that wore off
+// Runs even during drilling animation: effects keep ticking while digging
+func (es *EffectSystem) ProcessEffects(player *entities.Player, dt float32) {
+	player.TickStatusEffects(dt)
+}
@@ -0,0 +1,69 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/physics"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+const DefaultSightRadius = 3.0 // tiles beyond the player's edges that count as seen
+
+type ExplorationSystem struct {
+	world       *world.World
+	sightRadius float32 // tiles
+}
+
+func NewExplorationSystem(w *world.World, sightRadius float32) *ExplorationSystem {
+	return &ExplorationSystem{
+		world:       w,
+		sightRadius: sightRadius,
+	}
+}
+
+// RevealAroundPlayer marks every cell within sight radius of the player AABB as explored
+// Distance is measured from each cell's center to the nearest point of the AABB,
+// so the revealed area keeps the player's shape instead of a point-centered circle
+// An active flare widens the sight radius
+func (es *ExplorationSystem) RevealAroundPlayer(player *entities.Player) {
+	sightRadius := es.sightRadius + player.Status.Modifiers().SightBonus
+	sightPixels := sightRadius * world.TileSize
+	sightPixelsSq := sightPixels * sightPixels
+
+	sightArea := player.AABB
+	sightArea.X -= sightPixels
+	sightArea.Y -= sightPixels
+	sightArea.Width += 2 * sightPixels
+	sightArea.Height += 2 * sightPixels
+
+	minX, maxX, minY, maxY := physics.GetOccupiedTileRange(sightArea, world.TileSize)
+
+	for gridX := minX; gridX <= maxX; gridX++ {
+		for gridY := minY; gridY <= maxY; gridY++ {
+			cellCenterX := (float32(gridX) + 0.5) * world.TileSize
+			cellCenterY := (float32(gridY) + 0.5) * world.TileSize
+
+			dx := distanceOutside(cellCenterX, player.AABB.X, player.AABB.X+player.AABB.Width)
+			dy := distanceOutside(cellCenterY, player.AABB.Y, player.AABB.Y+player.AABB.Height)
+
+			if dx*dx+dy*dy <= sightPixelsSq {
+				es.world.MarkExplored(gridX, gridY)
+			}
+		}
+	}
+}
+
+// GetSightRadius returns the sight radius in tiles
+func (es *ExplorationSystem) GetSightRadius() float32 {
+	return es.sightRadius
+}
+
+// distanceOutside returns how far value lies outside [min, max] (0 when inside)
+func distanceOutside(value, min, max float32) float32 {
+	if value < min {
+		return min - value
+	}
+	if value > max {
+		return value - max
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+func TestExploration_RevealsCellsAroundPlayer(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	es := NewExplorationSystem(w, 2)
+
+	// Player occupies cell (10, 20)
+	player := entities.NewPlayer(10*world.TileSize+5, 20*world.TileSize+5)
+	es.RevealAroundPlayer(player)
+
+	if !w.IsExplored(10, 20) {
+		t.Error("Cell under the player should be explored")
+	}
+	if !w.IsExplored(12, 20) || !w.IsExplored(8, 20) || !w.IsExplored(10, 22) {
+		t.Error("Cells within sight radius should be explored")
+	}
+	if w.IsExplored(14, 20) || w.IsExplored(10, 24) {
+		t.Error("Cells beyond sight radius should stay unexplored")
+	}
+}
+
+func TestExploration_RevealIsRoundedAtCorners(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	es := NewExplorationSystem(w, 2)
+
+	player := entities.NewPlayer(10*world.TileSize+5, 20*world.TileSize+5)
+	es.RevealAroundPlayer(player)
+
+	// Diagonal corner at (±2, ±2) lies ~2.1 tiles from the player's corner
+	if w.IsExplored(13, 23) {
+		t.Error("Far diagonal corner should stay unexplored")
+	}
+}
+
+func TestExploration_LargerRadiusSeesFurther(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	es := NewExplorationSystem(w, 5)
+
+	player := entities.NewPlayer(10*world.TileSize+5, 20*world.TileSize+5)
+	es.RevealAroundPlayer(player)
+
+	if !w.IsExplored(15, 20) {
+		t.Error("Sight radius 5 should reveal cells 5 tiles away")
+	}
+	if es.GetSightRadius() != 5 {
+		t.Errorf("Expected sight radius 5, got %f", es.GetSightRadius())
+	}
+}
+
+func TestExploration_FlareWidensSight(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	es := NewExplorationSystem(w, 2)
+
+	player := entities.NewPlayer(10*world.TileSize+5, 20*world.TileSize+5)
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectFlare, entities.FlareDuration, 3))
+	es.RevealAroundPlayer(player)
+
+	if !w.IsExplored(15, 20) {
+		t.Error("Flare should add 3 tiles to the sight radius of 2")
+	}
+}
@@ -3,14 +3,32 @@ package systems
 import (
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
+// FuelActivity is what the engine is doing, which sets its base burn rate
+type FuelActivity int
+
 const (
-	// Fuel consumption rates in liters per second
-	FuelConsumptionMoving float32 = 10.0 / 30.0  // 0.33333 L/s when actively moving/drilling
-	FuelConsumptionIdle   float32 = 10.0 / 120.0 // 0.08333 L/s when idle (no inputs)
+	FuelIdle     FuelActivity = iota // No movement input
+	FuelDriving                      // Moving left/right or holding drill without drilling yet
+	FuelFlying                       // Thrusting upward
+	FuelDrilling                     // Drilling a tile (scaled by tile hardness)
+	FuelHovering                     // Holding altitude for a mid-air drill
 )
 
+// FuelActivityRates are base-engine burn rates in liters per second with an empty hold
+var FuelActivityRates = map[FuelActivity]float32{
+	FuelIdle:     10.0 / 120.0, // 0.08333 L/s
+	FuelDriving:  10.0 / 30.0,  // 0.33333 L/s
+	FuelFlying:   10.0 / 30.0,  // 0.33333 L/s
+	FuelDrilling: 10.0 / 30.0,  // 0.33333 L/s for the softest tile
+	FuelHovering: 10.0 / 12.0,  // 0.83333 L/s
+}
+
+// DrillHardnessFuelScale is the extra drilling burn on the hardest dirt (max depth) relative to the softest
+const DrillHardnessFuelScale = 0.5
+
 type FuelSystem struct {
 	// Empty for now - could add config or state later
 }
@@ -19,23 +37,15 @@ func NewFuelSystem() *FuelSystem {
 	return &FuelSystem{}
 }
 
-// ConsumeFuel drains fuel based on player input state
-// Movement inputs (Left, Right, Up, Drill) consume fuel faster than idle state
+// ConsumeFuel drains fuel at the player's current burn rate
 func (fs *FuelSystem) ConsumeFuel(
 	player *entities.Player,
 	inputState input.InputState,
 	dt float32,
 ) {
-	// Determine consumption rate based on input
-	var rate float32
-	if inputState.HasMovementInput() {
-		rate = FuelConsumptionMoving
-	} else {
-		rate = FuelConsumptionIdle
-	}
-
-	// Calculate fuel consumed this frame
-	fuelConsumed := rate * dt
+	// Calculate fuel consumed this frame (statuses like Overheated burn more, a worn tank leaks)
+	fuelConsumed := fs.FuelRate(player, inputState) * player.Status.Modifiers().FuelMultiplier * dt
+	fuelConsumed += player.FuelTank().LeakRate() * dt
 
 	// Drain fuel (clamp at zero, never go negative)
 	player.Fuel -= fuelConsumed
@@ -43,3 +53,43 @@ func (fs *FuelSystem) ConsumeFuel(
 		player.Fuel = 0
 	}
 }
+
+// FuelRate returns liters per second for the player's activity, divided by engine efficiency.
+// Drilling scales with tile hardness; moving the vehicle scales with its cargo load.
+func (fs *FuelSystem) FuelRate(player *entities.Player, inputState input.InputState) float32 {
+	activity := Activity(player, inputState)
+	rate := FuelActivityRates[activity]
+
+	switch activity {
+	case FuelDrilling:
+		rate *= drillHardnessFactor(player.DrillHardness)
+	case FuelDriving, FuelFlying, FuelHovering:
+		rate *= player.LoadFactor()
+	}
+
+	return rate / player.Engine().FuelEfficiency()
+}
+
+// Activity classifies what the engine is doing this frame
+func Activity(player *entities.Player, inputState input.InputState) FuelActivity {
+	switch {
+	case player.IsHovering:
+		return FuelHovering
+	case player.IsDrilling:
+		return FuelDrilling
+	case inputState.Up:
+		return FuelFlying
+	case inputState.HasMovementInput():
+		return FuelDriving
+	default:
+		return FuelIdle
+	}
+}
+
+// drillHardnessFactor is 1 for the softest tile, rising by DrillHardnessFuelScale per depth range of hit points
+func drillHardnessFactor(hitPoints float32) float32 {
+	if hitPoints <= world.MinTileHitPoints {
+		return 1
+	}
+	return 1 + DrillHardnessFuelScale*(hitPoints-world.MinTileHitPoints)/(world.MaxTileHitPoints-world.MinTileHitPoints)
+}
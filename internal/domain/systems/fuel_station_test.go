@@ -19,7 +19,7 @@ func TestFuelStationSystem_ProcessRefueling_FullTank(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 
 	initialMoney := player.Money
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Execute
 	system.ProcessRefueling(player, inputState)
@@ -44,7 +44,7 @@ func TestFuelStationSystem_ProcessRefueling_EmptyTank(t *testing.T) {
 
 	inputState := input.InputState{Sell: true}
 
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Execute
 	system.ProcessRefueling(player, inputState)
@@ -70,7 +70,7 @@ func TestFuelStationSystem_ProcessRefueling_PartialTankRoundedUp(t *testing.T) {
 
 	inputState := input.InputState{Sell: true}
 
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Execute
 	system.ProcessRefueling(player, inputState)
@@ -6,12 +6,13 @@ import (
 
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
-func TestFuelSystem_ConsumesMovingRateWhenMovingLeft(t *testing.T) {
+func TestFuelSystem_ConsumesDrivingRateWhenMovingLeft(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	if player.Fuel != fuelCapacity {
 		t.Fatalf("expected full tank (%.2f), got %.2f", fuelCapacity, player.Fuel)
@@ -21,65 +22,65 @@ func TestFuelSystem_ConsumesMovingRateWhenMovingLeft(t *testing.T) {
 	inputState := input.InputState{Left: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionMoving
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelDriving]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel after 1s moving left, got %.4f", expectedFuel, player.Fuel)
 	}
 }
 
-func TestFuelSystem_ConsumesMovingRateWhenMovingRight(t *testing.T) {
+func TestFuelSystem_ConsumesDrivingRateWhenMovingRight(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	inputState := input.InputState{Right: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionMoving
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelDriving]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel after 1s moving right, got %.4f", expectedFuel, player.Fuel)
 	}
 }
 
-func TestFuelSystem_ConsumesMovingRateWhenMovingUp(t *testing.T) {
+func TestFuelSystem_ConsumesFlyingRateWhenMovingUp(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	inputState := input.InputState{Up: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionMoving
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelFlying]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel after 1s flying up, got %.4f", expectedFuel, player.Fuel)
 	}
 }
 
-func TestFuelSystem_ConsumesMovingRateWhenDrilling(t *testing.T) {
+func TestFuelSystem_ConsumesDrivingRateWhenHoldingDrill(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
-	// Drilling should use movement rate (active work)
+	// Holding drill before a drill starts drives the engine like moving
 	inputState := input.InputState{Drill: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionMoving
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelDriving]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
-		t.Errorf("expected %.4f fuel after 1s drilling, got %.4f", expectedFuel, player.Fuel)
+		t.Errorf("expected %.4f fuel after 1s holding drill, got %.4f", expectedFuel, player.Fuel)
 	}
 }
 
 func TestFuelSystem_ConsumesIdleRateWhenNoInput(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// No input = idle state
 	inputState := input.InputState{}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionIdle
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelIdle]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel after 1s idle, got %.4f", expectedFuel, player.Fuel)
 	}
@@ -88,28 +89,28 @@ func TestFuelSystem_ConsumesIdleRateWhenNoInput(t *testing.T) {
 func TestFuelSystem_ConsumesIdleRateWhenOnlySellingInput(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Sell input alone should use idle rate (not active movement)
 	inputState := input.InputState{Sell: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionIdle
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelIdle]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel after 1s with sell input, got %.4f", expectedFuel, player.Fuel)
 	}
 }
 
-func TestFuelSystem_ConsumesMovingRateWhenMovingAndSelling(t *testing.T) {
+func TestFuelSystem_ConsumesFlyingRateWhenFlyingAndSelling(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Moving + selling = use movement rate (movement takes priority)
 	inputState := input.InputState{Up: true, Sell: true}
 	fs.ConsumeFuel(player, inputState, 1.0)
 
-	expectedFuel := fuelCapacity - FuelConsumptionMoving
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelFlying]
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f fuel with movement + sell, got %.4f", expectedFuel, player.Fuel)
 	}
@@ -161,10 +162,10 @@ func TestFuelSystem_FrameRateIndependence(t *testing.T) {
 
 func TestFuelSystem_FullTankDurationMoving(t *testing.T) {
 	// 10 liters in 30 seconds = 0.333 L/s
-	// Starting with 10L, moving continuously should last 30 seconds
+	// Starting with 10L, flying continuously should last 30 seconds
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	inputState := input.InputState{Up: true}
 	dt := float32(0.1) // Simulate 0.1 second frames
@@ -193,7 +194,7 @@ func TestFuelSystem_FullTankDurationIdle(t *testing.T) {
 	// Starting with 10L, idle should last 120 seconds
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	inputState := input.InputState{} // No input = idle
 	dt := float32(1.0)               // Simulate 1 second frames for speed
@@ -219,21 +220,114 @@ func TestFuelSystem_FullTankDurationIdle(t *testing.T) {
 func TestFuelSystem_MultipleConsumptionsAccumulate(t *testing.T) {
 	fs := NewFuelSystem()
 	player := entities.NewPlayer(0, 0)
-	fuelCapacity := player.FuelTank.Capacity()
+	fuelCapacity := player.FuelTank().Capacity()
 
 	// Consume fuel multiple times
 	inputState1 := input.InputState{Left: true}
-	fs.ConsumeFuel(player, inputState1, 1.0) // -0.0833L
+	fs.ConsumeFuel(player, inputState1, 1.0) // -0.3333L driving
 
 	inputState2 := input.InputState{}        // Idle
-	fs.ConsumeFuel(player, inputState2, 1.0) // -0.0167L
+	fs.ConsumeFuel(player, inputState2, 1.0) // -0.0833L
 
 	inputState3 := input.InputState{Up: true}
-	fs.ConsumeFuel(player, inputState3, 2.0) // -0.1667L (2 seconds moving)
+	fs.ConsumeFuel(player, inputState3, 2.0) // -0.6667L (2 seconds flying)
 
-	// Total should be: 10 - 0.0833 - 0.0167 - 0.1667 = 9.7333
-	expectedFuel := fuelCapacity - FuelConsumptionMoving - FuelConsumptionIdle - (FuelConsumptionMoving * 2.0)
+	// Total should be: 10 - 0.3333 - 0.0833 - 0.6667 = 8.9167
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelDriving] - FuelActivityRates[FuelIdle] - (FuelActivityRates[FuelFlying] * 2.0)
 	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
 		t.Errorf("expected %.4f after multiple consumptions, got %.4f", expectedFuel, player.Fuel)
 	}
 }
+
+func TestFuelSystem_ConsumesHoverRateWhileHovering(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	player.IsHovering = true
+	fuelCapacity := player.FuelTank().Capacity()
+
+	// Hovering burns the hover rate even without movement input
+	fs.ConsumeFuel(player, input.InputState{}, 1.0)
+
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelHovering]
+	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
+		t.Errorf("expected %.4f fuel after 1s hovering, got %.4f", expectedFuel, player.Fuel)
+	}
+}
+
+func TestFuelSystem_OverheatedBurnsMoreFuel(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	player.Status.Apply(entities.NewStatusEffect(entities.EffectOverheated, 5, 0))
+	fuelCapacity := player.Fuel
+
+	fs.ConsumeFuel(player, input.InputState{Left: true}, 1.0)
+
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelDriving]*entities.OverheatedFuelMultiplier
+	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
+		t.Errorf("expected %.4f fuel after 1s moving while overheated, got %.4f", expectedFuel, player.Fuel)
+	}
+}
+
+func TestFuelSystem_BetterEngineBurnsLess(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	player.Loadout[entities.SlotEngine] = entities.NewComponent(entities.SlotEngine, 5)
+	fuelCapacity := player.Fuel
+
+	fs.ConsumeFuel(player, input.InputState{Up: true}, 1.0)
+
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelFlying]/player.Engine().FuelEfficiency()
+	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
+		t.Errorf("expected %.4f fuel after 1s flying with Mk5 engine, got %.4f", expectedFuel, player.Fuel)
+	}
+}
+
+func TestFuelSystem_DrillingHardTilesBurnsMore(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	player.IsDrilling = true
+
+	player.DrillHardness = world.MinTileHitPoints
+	soft := fs.FuelRate(player, input.InputState{Drill: true})
+	player.DrillHardness = world.MaxTileHitPoints
+	hard := fs.FuelRate(player, input.InputState{Drill: true})
+
+	if math.Abs(float64(soft-FuelActivityRates[FuelDrilling])) > 0.0001 {
+		t.Errorf("expected the softest tile at the base drilling rate %.4f, got %.4f", FuelActivityRates[FuelDrilling], soft)
+	}
+	expectedHard := FuelActivityRates[FuelDrilling] * (1 + DrillHardnessFuelScale)
+	if math.Abs(float64(hard-expectedHard)) > 0.0001 {
+		t.Errorf("expected max-depth dirt to burn %.4f L/s, got %.4f", expectedHard, hard)
+	}
+}
+
+func TestFuelSystem_CargoLoadRaisesMovingBurn(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	empty := fs.FuelRate(player, input.InputState{Left: true})
+
+	player.OreInventory[entities.OreIron] = 10
+	loaded := fs.FuelRate(player, input.InputState{Left: true})
+	idle := fs.FuelRate(player, input.InputState{})
+
+	if math.Abs(float64(loaded-empty*player.LoadFactor())) > 0.0001 {
+		t.Errorf("expected driving burn scaled by load factor %.2f, got %.4f vs %.4f empty", player.LoadFactor(), loaded, empty)
+	}
+	if idle != FuelActivityRates[FuelIdle] {
+		t.Errorf("cargo shouldn't change the idle burn, got %.4f", idle)
+	}
+}
+
+func TestFuelSystem_WornTankLeaksOnTopOfBurn(t *testing.T) {
+	fs := NewFuelSystem()
+	player := entities.NewPlayer(0, 0)
+	player.WearComponents(1, entities.SlotFuelTank)
+	fuelCapacity := player.FuelTank().Capacity()
+
+	fs.ConsumeFuel(player, input.InputState{}, 1.0)
+
+	expectedFuel := fuelCapacity - FuelActivityRates[FuelIdle] - entities.MaxFuelLeakRate
+	if math.Abs(float64(player.Fuel-expectedFuel)) > 0.0001 {
+		t.Errorf("expected %.4f fuel after 1s idle with a broken tank, got %.4f", expectedFuel, player.Fuel)
+	}
+}
@@ -19,7 +19,7 @@ func TestHospitalSystem_ProcessHealing_FullHP(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 
 	initialMoney := player.Money
-	maxHP := player.Hull.MaxHP()
+	maxHP := player.Hull().MaxHP()
 
 	// Execute
 	system.ProcessHealing(player, inputState)
@@ -44,7 +44,7 @@ func TestHospitalSystem_ProcessHealing_ZeroHP(t *testing.T) {
 
 	inputState := input.InputState{Sell: true}
 
-	maxHP := player.Hull.MaxHP()
+	maxHP := player.Hull().MaxHP()
 
 	// Execute
 	system.ProcessHealing(player, inputState)
@@ -70,7 +70,7 @@ func TestHospitalSystem_ProcessHealing_PartialHPRoundedUp(t *testing.T) {
 
 	inputState := input.InputState{Sell: true}
 
-	maxHP := player.Hull.MaxHP()
+	maxHP := player.Hull().MaxHP()
 
 	// Execute
 	system.ProcessHealing(player, inputState)
@@ -165,7 +165,7 @@ func TestHospitalSystem_ProcessHealing_SmallFractionalHP(t *testing.T) {
 
 	inputState := input.InputState{Sell: true}
 
-	maxHP := player.Hull.MaxHP()
+	maxHP := player.Hull().MaxHP()
 
 	// Execute
 	system.ProcessHealing(player, inputState)
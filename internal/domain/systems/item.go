@@ -3,71 +3,91 @@ package systems
 import (
 	"github.com/Kishlin/drill-game/internal/domain/entities"
 	"github.com/Kishlin/drill-game/internal/domain/input"
-	"github.com/Kishlin/drill-game/internal/domain/types"
-	"github.com/Kishlin/drill-game/internal/domain/world"
 )
 
+// ItemSystem drives the hotbar: cycling the selected item and using it
+// Item behaviour lives in the registry's effect functions; ItemSystem is their ItemEnvironment
 type ItemSystem struct {
-	world  *world.World
-	spawnX float32
-	spawnY float32
+	registry *entities.ItemRegistry
+	bombs    *BombSystem
+	spawnX   float32
+	spawnY   float32
 }
 
-func NewItemSystem(w *world.World, spawnX, spawnY float32) *ItemSystem {
+func NewItemSystem(registry *entities.ItemRegistry, bombs *BombSystem, spawnX, spawnY float32) *ItemSystem {
 	return &ItemSystem{
-		world:  w,
-		spawnX: spawnX,
-		spawnY: spawnY,
+		registry: registry,
+		bombs:    bombs,
+		spawnX:   spawnX,
+		spawnY:   spawnY,
 	}
 }
 
-// ProcessItemUsage checks for item inputs and applies effects
+// ProcessItemUsage handles hotbar selection and using the selected item
 func (is *ItemSystem) ProcessItemUsage(player *entities.Player, inputState input.InputState) {
-	if inputState.UseTeleport && player.UseItem(entities.ItemTeleport) {
-		is.applyTeleport(player)
+	if inputState.CycleItem != 0 {
+		player.SelectedItem = is.cycleSelection(player.SelectedItem, inputState.CycleItem)
 	}
-	if inputState.UseRepair && player.UseItem(entities.ItemRepair) {
-		is.applyRepair(player)
+	if inputState.UseSelectedItem {
+		is.UseItem(player, is.Selected(player))
 	}
-	if inputState.UseRefuel && player.UseItem(entities.ItemRefuel) {
-		is.applyRefuel(player)
-	}
-	if inputState.UseBomb && player.UseItem(entities.ItemBomb) {
-		is.applyBomb(player, 2)
+}
+
+// UseItem applies the item's effect and consumes one, returns success
+// The item is kept when the player has none left or its effect refuses to apply
+func (is *ItemSystem) UseItem(player *entities.Player, id entities.ItemID) bool {
+	def, ok := is.registry.Get(id)
+	if !ok || player.ItemInventory[id] <= 0 {
+		return false
 	}
-	if inputState.UseBigBomb && player.UseItem(entities.ItemBigBomb) {
-		is.applyBomb(player, 4)
+	if !def.Use(player, is) {
+		return false
 	}
+	return player.UseItem(id)
 }
 
-func (is *ItemSystem) applyTeleport(player *entities.Player) {
-	player.AABB.X = is.spawnX
-	player.AABB.Y = is.spawnY
-	player.Velocity = types.Zero()
-	player.OnGround = false
+// Selected returns the player's hotbar selection, defaulting to the first registered item
+func (is *ItemSystem) Selected(player *entities.Player) entities.ItemID {
+	if _, ok := is.registry.Get(player.SelectedItem); ok {
+		return player.SelectedItem
+	}
+	ids := is.registry.IDs()
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
 }
 
-func (is *ItemSystem) applyRepair(player *entities.Player) {
-	player.HP = player.Hull.MaxHP()
+// cycleSelection moves the selection by step slots, wrapping around the hotbar
+func (is *ItemSystem) cycleSelection(current entities.ItemID, step int) entities.ItemID {
+	ids := is.registry.IDs()
+	if len(ids) == 0 {
+		return current
+	}
+
+	index := 0
+	for i, id := range ids {
+		if id == current {
+			index = i
+			break
+		}
+	}
+
+	index = ((index+step)%len(ids) + len(ids)) % len(ids)
+	return ids[index]
 }
 
-func (is *ItemSystem) applyRefuel(player *entities.Player) {
-	player.Fuel = player.FuelTank.Capacity()
+// SpawnPoint returns where teleporting puts the player (entities.ItemEnvironment)
+func (is *ItemSystem) SpawnPoint() (float32, float32) {
+	return is.spawnX, is.spawnY
 }
 
-func (is *ItemSystem) applyBomb(player *entities.Player, radius int) {
-	// Calculate player center in grid coordinates
-	centerX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
-	centerY := int((player.AABB.Y + player.AABB.Height/2) / world.TileSize)
+// PlaceBomb hands a lit bomb to the bomb system (entities.ItemEnvironment)
+func (is *ItemSystem) PlaceBomb(spec entities.BombSpec, centerX, centerY float32) {
+	is.bombs.PlaceBomb(spec, centerX, centerY)
+}
 
-	// Destroy tiles in circular radius (ore is lost, not collected)
-	for dy := -radius; dy <= radius; dy++ {
-		for dx := -radius; dx <= radius; dx++ {
-			// Circular blast check
-			if dx*dx+dy*dy <= radius*radius {
-				gridX, gridY := centerX+dx, centerY+dy
-				is.world.DrillTileAtGrid(gridX, gridY)
-			}
-		}
-	}
+// GetRegistry returns the item definitions in hotbar order for rendering
+func (is *ItemSystem) GetRegistry() *entities.ItemRegistry {
+	return is.registry
 }
@@ -29,13 +29,15 @@ func (iss *ItemShopSystem) ProcessPurchase(player *entities.Player, inputState i
 	}
 }
 
+// tryPurchase only charges the player if the item fits under its stack limit
 func (iss *ItemShopSystem) tryPurchase(player *entities.Player, shop *entities.ItemShop) {
-	if !player.CanAfford(shop.Price) {
+	if !player.CanAfford(shop.Item.Price) {
 		return
 	}
 
-	player.Money -= shop.Price
-	player.AddItem(shop.ItemType)
+	if player.AddItem(shop.Item) {
+		player.Money -= shop.Item.Price
+	}
 }
 
 // GetShops returns all item shops for rendering
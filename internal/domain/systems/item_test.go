@@ -0,0 +1,84 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+)
+
+func TestItemSystem_CycleSelectionWraps(t *testing.T) {
+	is := NewItemSystem(entities.NewDefaultItemRegistry(), nil, 0, 0)
+	player := entities.NewPlayer(0, 0)
+
+	if is.Selected(player) != entities.ItemTeleport {
+		t.Fatalf("Selection should default to the first item, got %q", is.Selected(player))
+	}
+
+	is.ProcessItemUsage(player, input.InputState{CycleItem: -1})
+	if player.SelectedItem != entities.ItemFlare {
+		t.Errorf("Cycling back from the first slot should wrap to the last, got %q", player.SelectedItem)
+	}
+
+	is.ProcessItemUsage(player, input.InputState{CycleItem: 1})
+	if player.SelectedItem != entities.ItemTeleport {
+		t.Errorf("Cycling forward from the last slot should wrap to the first, got %q", player.SelectedItem)
+	}
+}
+
+func TestItemSystem_UseSelectedItemAppliesEffect(t *testing.T) {
+	is := NewItemSystem(entities.NewDefaultItemRegistry(), nil, 0, 0)
+	player := entities.NewPlayer(0, 0)
+	player.HP = 1
+	player.ItemInventory[entities.ItemRepair] = 1
+	player.SelectedItem = entities.ItemRepair
+
+	is.ProcessItemUsage(player, input.InputState{UseSelectedItem: true})
+
+	if player.HP != player.Hull().MaxHP() {
+		t.Errorf("Repair should restore HP to max, got %f", player.HP)
+	}
+	if player.ItemInventory[entities.ItemRepair] != 0 {
+		t.Errorf("Repair kit should be consumed, got %d", player.ItemInventory[entities.ItemRepair])
+	}
+}
+
+func TestItemSystem_RefusedEffectKeepsItem(t *testing.T) {
+	registry := entities.NewItemRegistry()
+	registry.Register(entities.ItemDefinition{
+		ID:         "dud",
+		StackLimit: 1,
+		Use:        func(*entities.Player, entities.ItemEnvironment) bool { return false },
+	})
+	is := NewItemSystem(registry, nil, 0, 0)
+	player := entities.NewPlayer(0, 0)
+	player.ItemInventory["dud"] = 1
+
+	if is.UseItem(player, "dud") {
+		t.Error("UseItem should fail when the effect refuses")
+	}
+	if player.ItemInventory["dud"] != 1 {
+		t.Errorf("Refused item should stay in the inventory, got %d", player.ItemInventory["dud"])
+	}
+	if is.UseItem(player, "missing") {
+		t.Error("Unregistered items can't be used")
+	}
+}
+
+func TestItemShopSystem_NoChargeAtStackLimit(t *testing.T) {
+	item, _ := entities.NewDefaultItemRegistry().Get(entities.ItemTeleport)
+	shop := entities.NewItemShop(0, 0, item)
+	iss := NewItemShopSystem(shop)
+	player := entities.NewPlayer(0, 0)
+	player.ItemInventory[entities.ItemTeleport] = item.StackLimit
+	money := player.Money
+
+	iss.ProcessPurchase(player, input.InputState{Sell: true})
+
+	if player.Money != money {
+		t.Errorf("Player should not be charged for an item that doesn't fit, paid %d", money-player.Money)
+	}
+	if player.ItemInventory[entities.ItemTeleport] != item.StackLimit {
+		t.Errorf("Inventory should stay at the stack limit, got %d", player.ItemInventory[entities.ItemTeleport])
+	}
+}
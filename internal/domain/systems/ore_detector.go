@@ -0,0 +1,92 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+const OreDetectionDuration = 5.0 // seconds detected ores stay highlighted
+
+// OreDetection is a single ore tile surfaced by a scan
+type OreDetection struct {
+	GridX, GridY int
+	OreType      entities.OreType
+}
+
+type OreDetectorSystem struct {
+	world             *world.World
+	cooldownRemaining float32
+	detections        []OreDetection
+	detectionTimeLeft float32
+}
+
+func NewOreDetectorSystem(w *world.World) *OreDetectorSystem {
+	return &OreDetectorSystem{world: w}
+}
+
+// ProcessScan ticks timers and runs a scan when requested and off cooldown
+// Runs even during drilling animation so long drills can be spent scanning
+func (ods *OreDetectorSystem) ProcessScan(
+	player *entities.Player,
+	inputState input.InputState,
+	dt float32,
+) {
+	ods.tick(dt)
+
+	if !inputState.Scan || ods.cooldownRemaining > 0 {
+		return
+	}
+
+	ods.scan(player)
+}
+
+func (ods *OreDetectorSystem) tick(dt float32) {
+	ods.cooldownRemaining -= dt
+	if ods.cooldownRemaining < 0 {
+		ods.cooldownRemaining = 0
+	}
+
+	ods.detectionTimeLeft -= dt
+	if ods.detectionTimeLeft <= 0 {
+		ods.detectionTimeLeft = 0
+		ods.detections = nil
+	}
+}
+
+// scan collects every ore tile within the detector's radius around the player center
+func (ods *OreDetectorSystem) scan(player *entities.Player) {
+	centerX := int((player.AABB.X + player.AABB.Width/2) / world.TileSize)
+	centerY := int((player.AABB.Y + player.AABB.Height/2) / world.TileSize)
+
+	var detections []OreDetection
+	ods.world.ForEachTileInRadius(centerX, centerY, player.OreDetector().ScanRadius(), func(gridX, gridY int, tile *entities.Tile) {
+		if tile.Type != entities.TileTypeOre {
+			return
+		}
+		detections = append(detections, OreDetection{
+			GridX:   gridX,
+			GridY:   gridY,
+			OreType: tile.OreType,
+		})
+	})
+
+	ods.detections = detections
+	ods.detectionTimeLeft = OreDetectionDuration
+	ods.cooldownRemaining = player.OreDetector().Cooldown()
+}
+
+// GetDetections returns ores highlighted by the last scan (empty once expired)
+func (ods *OreDetectorSystem) GetDetections() []OreDetection {
+	return ods.detections
+}
+
+// GetDetectionTimeLeft returns how long current detections stay visible (seconds)
+func (ods *OreDetectorSystem) GetDetectionTimeLeft() float32 {
+	return ods.detectionTimeLeft
+}
+
+// GetCooldownRemaining returns seconds until the next scan is available
+func (ods *OreDetectorSystem) GetCooldownRemaining() float32 {
+	return ods.cooldownRemaining
+}
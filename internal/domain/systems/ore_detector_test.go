@@ -0,0 +1,100 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// setupDetectorTest places a player in an empty pocket at cell (10, 30)
+func setupDetectorTest() (*world.World, *entities.Player, *OreDetectorSystem) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	player := entities.NewPlayer(10*world.TileSize+5, 30*world.TileSize+5)
+
+	// Clear a wide area so only test ores are detected
+	w.ForEachTileInRect(0, 40, 10, 60, func(gridX, gridY int, _ *entities.Tile) {
+		w.SetTile(gridX, gridY, nil)
+	})
+
+	return w, player, NewOreDetectorSystem(w)
+}
+
+func TestOreDetector_ScanFindsOreInRadius(t *testing.T) {
+	w, player, ods := setupDetectorTest()
+	w.SetTile(12, 30, entities.NewOreTile(entities.OreDiamond)) // 2 tiles away
+	w.SetTile(10, 32, entities.NewTile(entities.TileTypeDirt))  // Dirt is ignored
+	w.SetTile(25, 30, entities.NewOreTile(entities.OreGold))    // Outside base radius
+
+	ods.ProcessScan(player, input.InputState{Scan: true}, 0.016)
+
+	detections := ods.GetDetections()
+	if len(detections) != 1 {
+		t.Fatalf("Expected 1 detection, got %d", len(detections))
+	}
+	if detections[0].GridX != 12 || detections[0].GridY != 30 || detections[0].OreType != entities.OreDiamond {
+		t.Errorf("Expected diamond at (12,30), got %+v", detections[0])
+	}
+}
+
+func TestOreDetector_UpgradedRadiusFindsMore(t *testing.T) {
+	w, player, ods := setupDetectorTest()
+	w.SetTile(25, 30, entities.NewOreTile(entities.OreGold)) // 15 tiles away
+	player.Loadout[entities.SlotOreDetector] = entities.NewComponent(entities.SlotOreDetector, 5)
+
+	ods.ProcessScan(player, input.InputState{Scan: true}, 0.016)
+
+	if len(ods.GetDetections()) != 1 {
+		t.Errorf("Mk5 detector should reach ore 15 tiles away, got %d detections", len(ods.GetDetections()))
+	}
+}
+
+func TestOreDetector_CooldownBlocksRescan(t *testing.T) {
+	w, player, ods := setupDetectorTest()
+
+	ods.ProcessScan(player, input.InputState{Scan: true}, 0.016)
+	if ods.GetCooldownRemaining() != player.OreDetector().Cooldown() {
+		t.Errorf("Expected cooldown %f after scan, got %f", player.OreDetector().Cooldown(), ods.GetCooldownRemaining())
+	}
+
+	// Ore appears after the first scan; a second scan on cooldown must not see it
+	w.SetTile(11, 30, entities.NewOreTile(entities.OreCopper))
+	ods.ProcessScan(player, input.InputState{Scan: true}, 1.0)
+	if len(ods.GetDetections()) != 0 {
+		t.Error("Scan on cooldown should not refresh detections")
+	}
+
+	// Once the cooldown elapses, scanning works again
+	ods.ProcessScan(player, input.InputState{}, player.OreDetector().Cooldown())
+	ods.ProcessScan(player, input.InputState{Scan: true}, 0.016)
+	if len(ods.GetDetections()) != 1 {
+		t.Error("Scan should work again after cooldown")
+	}
+}
+
+func TestOreDetector_DetectionsExpire(t *testing.T) {
+	w, player, ods := setupDetectorTest()
+	w.SetTile(11, 30, entities.NewOreTile(entities.OreIron))
+
+	ods.ProcessScan(player, input.InputState{Scan: true}, 0.016)
+	if len(ods.GetDetections()) != 1 {
+		t.Fatal("Expected ore to be detected")
+	}
+
+	ods.ProcessScan(player, input.InputState{}, OreDetectionDuration+0.1)
+	if len(ods.GetDetections()) != 0 {
+		t.Error("Detections should expire after OreDetectionDuration")
+	}
+}
+
+func TestOreDetector_NoScanWithoutInput(t *testing.T) {
+	w, player, ods := setupDetectorTest()
+	w.SetTile(11, 30, entities.NewOreTile(entities.OreIron))
+
+	ods.ProcessScan(player, input.InputState{}, 0.016)
+
+	if len(ods.GetDetections()) != 0 || ods.GetCooldownRemaining() != 0 {
+		t.Error("Detector should stay idle without scan input")
+	}
+}
@@ -21,43 +21,65 @@ func (ps *PhysicsSystem) UpdatePhysics(
 	inputState input.InputState,
 	dt float32,
 ) {
-	physics.ApplyHeatDamage(player, dt)
+	physics.ApplyHeatDamage(player, ps.world.GetConfig(), dt)
+	physics.ApplyPressureDamage(player, ps.world.GetConfig(), dt)
 
 	if player.IsDrilling {
 		return
 	}
 
+	// Status effects scale the engine, cap falls and can lock out the controls
+	mods := player.Status.Modifiers()
+	if mods.Stunned {
+		inputState = input.NewInputState()
+	}
+
+	// Cargo mass weighs the engine down: acceleration and climb rate shrink with the load
+	// Climb thrust never drops below gravity plus a small margin, so a loaded vehicle still lifts off
+	load := player.LoadFactor()
+	flyAcceleration := player.Engine().FlyAcceleration() * mods.SpeedMultiplier
+	flyAcceleration = max(flyAcceleration/load, min(flyAcceleration, physics.Gravity+physics.MinClimbAcceleration))
+
 	// 1. Apply movement and gravity to velocity
 	player.Velocity = physics.ApplyHorizontalMovement(
 		player.Velocity, inputState, dt,
-		player.Engine.MaxSpeed(), player.Engine.Acceleration(),
+		player.Engine().MaxSpeed()*mods.SpeedMultiplier, player.Engine().Acceleration()*mods.SpeedMultiplier/load,
 	)
 	player.Velocity = physics.ApplyVerticalMovement(
 		player.Velocity, inputState, dt,
-		player.Engine.FlyAcceleration(), player.Engine.MaxUpwardSpeed(),
+		flyAcceleration, player.Engine().MaxUpwardSpeed()*mods.SpeedMultiplier/load,
 	)
 	player.Velocity = physics.ApplyGravity(player.Velocity, dt)
+	if mods.FallSpeedCapped {
+		player.Velocity = physics.CapFallSpeed(player.Velocity, physics.ParachuteFallSpeed)
+	}
 
 	// 2. AXIS-SEPARATED COLLISION RESOLUTION
 
-	// X-axis: integrate position → check → resolve
-	player.AABB.X += player.Velocity.X * dt
-	collisionsX := physics.CheckCollisions(player.AABB, ps.world)
-	player.AABB, player.Velocity = physics.ResolveCollisionsX(player.AABB, player.Velocity, collisionsX)
+	// X-axis: integrate position in collision-checked steps (no tunneling on long frames)
+	xSpeedBeforeImpact := player.Velocity.X
+	player.AABB, player.Velocity = physics.MoveAndCollideX(player.AABB, player.Velocity, player.Velocity.X*dt, ps.world)
 
-	// Y-axis: integrate position → check → resolve
-	player.AABB.Y += player.Velocity.Y * dt
-	collisionsY := physics.CheckCollisions(player.AABB, ps.world)
+	// Ramming a wall hurts and knocks the vehicle back like a landing would
+	if xSpeedBeforeImpact != 0 && player.Velocity.X == 0 {
+		player.Velocity.X = physics.ApplyImpactDamage(player, xSpeedBeforeImpact)
+	}
 
-	// Capture state before Y-resolution for fall damage calculation
+	// Capture state before Y-resolution for fall and ceiling impact damage
 	wasAirborne := !player.OnGround
-	ySpeedBeforeLanding := player.Velocity.Y
+	ySpeedBeforeImpact := player.Velocity.Y
 
-	player.AABB, player.Velocity, player.OnGround = physics.ResolveCollisionsY(player.AABB, player.Velocity, collisionsY)
+	// Y-axis: same stepped integration, which also detects ground
+	player.AABB, player.Velocity, player.OnGround = physics.MoveAndCollideY(player.AABB, player.Velocity, player.Velocity.Y*dt, ps.world)
 
 	// Apply fall damage on landing transition
 	if wasAirborne && player.OnGround {
-		physics.ApplyFallDamage(player, ySpeedBeforeLanding)
+		physics.ApplyFallDamage(player, ySpeedBeforeImpact)
+	}
+
+	// Hitting a ceiling on the way up hurts and knocks the vehicle back down
+	if ySpeedBeforeImpact < 0 && player.Velocity.Y == 0 {
+		player.Velocity.Y = physics.ApplyImpactDamage(player, ySpeedBeforeImpact)
 	}
 
 	// 3. Enforce world boundary constraints (prevent player from leaving game area)
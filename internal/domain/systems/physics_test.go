@@ -0,0 +1,141 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// climbAfter flies a player up from open sky for duration and returns its upward speed
+func climbAfter(player *entities.Player, duration float32) float32 {
+	ps := NewPhysicsSystem(world.NewWorld(7680, 64000, 640, 42))
+	for elapsed := float32(0); elapsed < duration; elapsed += 0.01 {
+		ps.UpdatePhysics(player, input.InputState{Up: true}, 0.01)
+	}
+	return -player.Velocity.Y
+}
+
+func TestPhysics_CargoMassSlowsClimb(t *testing.T) {
+	empty := entities.NewPlayer(100, 100)
+	loaded := entities.NewPlayer(100, 100)
+	loaded.OreInventory[entities.OrePlatinum] = 10
+
+	emptySpeed := climbAfter(empty, 0.2)
+	loadedSpeed := climbAfter(loaded, 0.2)
+
+	if loadedSpeed >= emptySpeed {
+		t.Errorf("Loaded player should climb slower: empty %f px/s, loaded %f px/s", emptySpeed, loadedSpeed)
+	}
+}
+
+func TestPhysics_CargoMassLowersMaxClimbSpeed(t *testing.T) {
+	player := entities.NewPlayer(100, 200)
+	player.Loadout[entities.SlotEngine] = entities.NewComponent(entities.SlotEngine, 5)
+	player.OreInventory[entities.OrePlatinum] = 10
+
+	speed := climbAfter(player, 2.0)
+
+	maxSpeed := -player.Engine().MaxUpwardSpeed() / player.LoadFactor()
+	if speed > maxSpeed+0.01 {
+		t.Errorf("Climb speed %f should be capped at %f under load", speed, maxSpeed)
+	}
+}
+
+func TestPhysics_FullPlatinumHoldStillClimbsWithBaseEngine(t *testing.T) {
+	empty := entities.NewPlayer(100, 200)
+	loaded := entities.NewPlayer(100, 200)
+	loaded.Loadout[entities.SlotCargoHold] = entities.NewComponent(entities.SlotCargoHold, 5)
+	loaded.OreInventory[entities.OrePlatinum] = loaded.CargoHold().Capacity()
+
+	emptySpeed := climbAfter(empty, 0.2)
+	loadedSpeed := climbAfter(loaded, 0.2)
+
+	if loadedSpeed <= 0 {
+		t.Fatalf("Base engine should still lift a full Mk5 hold of Platinum, climbed at %f px/s", loadedSpeed)
+	}
+	if loadedSpeed >= emptySpeed/2 {
+		t.Errorf("Full hold should climb much slower: empty %f px/s, loaded %f px/s", emptySpeed, loadedSpeed)
+	}
+}
+
+// skyWorldWithTiles returns a world with solid tiles placed in the open sky at the given grid cells
+func skyWorldWithTiles(cells ...[2]int) *world.World {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	for _, cell := range cells {
+		w.GetTileAtGrid(cell[0], cell[1]) // Generate the chunk first so it doesn't overwrite the tile
+		w.SetTile(cell[0], cell[1], entities.NewTile(entities.TileTypeDirt))
+	}
+	return w
+}
+
+func TestPhysics_RammingWallDealsDamageAndKnocksBack(t *testing.T) {
+	ps := NewPhysicsSystem(skyWorldWithTiles([2]int{3, 1}, [2]int{3, 2}))
+	player := entities.NewPlayer(100, 100)
+	player.Velocity.X = player.Engine().MaxSpeed()
+
+	for i := 0; i < 100 && player.Velocity.X > 0; i++ {
+		ps.UpdatePhysics(player, input.InputState{Right: true}, 0.005)
+	}
+
+	// (450 - 350) / 100 = 1 HP at the base engine's top speed
+	expectedHP := player.Hull().MaxHP() - 1
+	if player.HP < expectedHP-0.01 || player.HP > expectedHP+0.01 {
+		t.Errorf("Expected HP %.2f after ramming a wall, got %.2f", expectedHP, player.HP)
+	}
+	if player.Velocity.X >= 0 {
+		t.Errorf("Expected a knockback away from the wall, got X velocity %f", player.Velocity.X)
+	}
+}
+
+func TestPhysics_HittingCeilingDealsDamageAndKnocksDown(t *testing.T) {
+	ps := NewPhysicsSystem(skyWorldWithTiles([2]int{1, 2}, [2]int{2, 2}))
+	player := entities.NewPlayer(100, 300)
+	player.Velocity.Y = player.Engine().MaxUpwardSpeed()
+
+	for i := 0; i < 100 && player.Velocity.Y < 0; i++ {
+		ps.UpdatePhysics(player, input.InputState{Up: true}, 0.005)
+	}
+
+	if player.HP >= player.Hull().MaxHP() {
+		t.Error("Hitting the ceiling at full climb speed should deal damage")
+	}
+	if player.Velocity.Y <= 0 {
+		t.Errorf("Expected a knockback down from the ceiling, got Y velocity %f", player.Velocity.Y)
+	}
+}
+
+func TestPhysics_SlowWallContactIsFree(t *testing.T) {
+	ps := NewPhysicsSystem(skyWorldWithTiles([2]int{3, 1}, [2]int{3, 2}))
+	player := entities.NewPlayer(130, 100)
+
+	for i := 0; i < 10; i++ {
+		ps.UpdatePhysics(player, input.InputState{Right: true}, 0.01)
+	}
+
+	if player.HP != player.Hull().MaxHP() {
+		t.Errorf("Nudging a wall shouldn't hurt, got HP %.2f", player.HP)
+	}
+}
+
+func TestPhysics_LongFrameLandsOnThinLedge(t *testing.T) {
+	ps := NewPhysicsSystem(skyWorldWithTiles([2]int{1, 6}, [2]int{2, 6})) // Ledge at Y 384..448
+	player := entities.NewPlayer(100, 100)
+	player.Velocity.Y = 900
+
+	// Two 0.25s frames: the second one would jump from above the ledge to below it
+	for i := 0; i < 2; i++ {
+		ps.UpdatePhysics(player, input.InputState{}, 0.25)
+	}
+
+	if !player.OnGround {
+		t.Fatalf("Expected to land on the ledge, ended at Y=%f", player.AABB.Y)
+	}
+	if expectedY := float32(384 - entities.PlayerHeight); player.AABB.Y != expectedY {
+		t.Errorf("Expected Y=%f on top of the ledge, got %f", expectedY, player.AABB.Y)
+	}
+	if player.HP >= player.Hull().MaxHP() {
+		t.Error("Landing at that speed should still deal fall damage")
+	}
+}
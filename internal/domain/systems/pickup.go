@@ -0,0 +1,119 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/physics"
+	"github.com/Kishlin/drill-game/internal/domain/types"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+const PickupGroundFriction = 600.0 // px/s² horizontal slowdown for pickups resting on tiles
+
+// PickupSystem owns the loose objects in the world that aren't tiles
+// They fall and collide using the same AABB functions as the player
+type PickupSystem struct {
+	world   *world.World
+	pickups []*entities.Pickup
+}
+
+func NewPickupSystem(w *world.World) *PickupSystem {
+	return &PickupSystem{world: w}
+}
+
+// SpawnOre adds a loose ore pickup centered on the given pixel position
+func (ps *PickupSystem) SpawnOre(oreType entities.OreType, centerX, centerY float32) *entities.Pickup {
+	pickup := entities.NewOrePickup(centerX, centerY, oreType)
+	ps.pickups = append(ps.pickups, pickup)
+	return pickup
+}
+
+// SpawnDroppedOre turns ore dropped by the cargo system into pickups
+// Dropped ore lands on or next to the player, so it waits for the player to move off it before it can be collected
+func (ps *PickupSystem) SpawnDroppedOre(dropped []DroppedOre) {
+	for _, ore := range dropped {
+		ps.SpawnOre(ore.OreType, ore.X, ore.Y).AwaitsClear = true
+	}
+}
+
+// UpdatePickups moves every pickup and lets the player collect those it touches
+// Pickups the hold has no room for stay where they are
+func (ps *PickupSystem) UpdatePickups(player *entities.Player, dt float32) {
+	remaining := ps.pickups[:0]
+
+	for _, pickup := range ps.pickups {
+		ps.movePickup(pickup, dt)
+
+		// Fell out of the bottom of the world
+		if pickup.AABB.Y > ps.world.Height {
+			continue
+		}
+
+		touching := player.AABB.Intersects(pickup.AABB)
+		if !touching {
+			pickup.AwaitsClear = false
+		}
+
+		if touching && pickup.CanBeCollected() && player.AddOre(pickup.OreType) {
+			continue
+		}
+
+		remaining = append(remaining, pickup)
+	}
+
+	// Clear dropped references so collected pickups can be garbage collected
+	for i := len(remaining); i < len(ps.pickups); i++ {
+		ps.pickups[i] = nil
+	}
+	ps.pickups = remaining
+}
+
+// movePickup applies gravity, ground friction and tile collision, then ticks the collect delay
+func (ps *PickupSystem) movePickup(pickup *entities.Pickup, dt float32) {
+	if pickup.CollectDelay > 0 {
+		pickup.CollectDelay -= dt
+	}
+
+	if pickup.OnGround {
+		pickup.Velocity.X = applyFriction(pickup.Velocity.X, PickupGroundFriction*dt)
+	}
+
+	pickup.AABB, pickup.Velocity, pickup.OnGround = moveLooseBody(ps.world, pickup.AABB, pickup.Velocity, dt)
+}
+
+// moveLooseBody applies gravity and axis-separated tile collision to a loose object, like the player's physics
+// Loose objects are kept inside the world horizontally
+func moveLooseBody(w *world.World, aabb types.AABB, velocity types.Vec2, dt float32) (types.AABB, types.Vec2, bool) {
+	velocity = physics.ApplyGravity(velocity, dt)
+
+	aabb, velocity = physics.MoveAndCollideX(aabb, velocity, velocity.X*dt, w)
+
+	var onGround bool
+	aabb, velocity, onGround = physics.MoveAndCollideY(aabb, velocity, velocity.Y*dt, w)
+
+	maxX := w.Width - aabb.Width
+	if aabb.X < 0 {
+		aabb.X = 0
+		velocity.X = 0
+	} else if aabb.X > maxX {
+		aabb.X = maxX
+		velocity.X = 0
+	}
+
+	return aabb, velocity, onGround
+}
+
+// applyFriction moves speed toward zero by amount without reversing direction
+func applyFriction(speed, amount float32) float32 {
+	if speed > amount {
+		return speed - amount
+	}
+	if speed < -amount {
+		return speed + amount
+	}
+	return 0
+}
+
+// GetPickups returns every loose pickup currently in the world
+func (ps *PickupSystem) GetPickups() []*entities.Pickup {
+	return ps.pickups
+}
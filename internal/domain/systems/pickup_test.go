@@ -0,0 +1,129 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+	"github.com/Kishlin/drill-game/internal/domain/world"
+)
+
+// settlePickups runs the pickup system for the given number of 60 FPS frames
+func settlePickups(ps *PickupSystem, player *entities.Player, frames int) {
+	for i := 0; i < frames; i++ {
+		ps.UpdatePickups(player, 1.0/60.0)
+	}
+}
+
+func TestPickupSystem_PickupFallsAndRestsOnGround(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	ps := NewPickupSystem(w)
+	player := entities.NewPlayer(2000, 500) // Far away: never touches the pickup
+
+	pickup := ps.SpawnOre(entities.OreIron, 300, 400)
+	settlePickups(ps, player, 120)
+
+	if !pickup.OnGround {
+		t.Fatal("Pickup should have landed")
+	}
+	if bottom := pickup.AABB.Y + pickup.AABB.Height; bottom != 640 {
+		t.Errorf("Pickup should rest on the surface at 640, bottom at %f", bottom)
+	}
+	if len(ps.GetPickups()) != 1 {
+		t.Errorf("Pickup should still be in the world, got %d", len(ps.GetPickups()))
+	}
+}
+
+func TestPickupSystem_PlayerCollectsOnContactAfterDelay(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	ps := NewPickupSystem(w)
+	player := entities.NewPlayer(128, 500)
+	centerX, centerY := playerCenter(player)
+
+	ps.SpawnOre(entities.OreGold, centerX, centerY)
+
+	ps.UpdatePickups(player, 1.0/60.0)
+	if player.OreInventory[entities.OreGold] != 0 {
+		t.Fatal("Fresh pickup should not be collected before its delay runs out")
+	}
+
+	// Keep the pickup pinned to the player while the delay runs out
+	for i := 0; i < 90 && len(ps.GetPickups()) > 0; i++ {
+		ps.GetPickups()[0].AABB.X = centerX - entities.PickupSize/2
+		ps.GetPickups()[0].AABB.Y = centerY - entities.PickupSize/2
+		ps.GetPickups()[0].Velocity.Y = 0
+		ps.UpdatePickups(player, 1.0/60.0)
+	}
+
+	if player.OreInventory[entities.OreGold] != 1 {
+		t.Errorf("Expected gold collected, got %d", player.OreInventory[entities.OreGold])
+	}
+	if len(ps.GetPickups()) != 0 {
+		t.Errorf("Collected pickup should be removed, got %d", len(ps.GetPickups()))
+	}
+}
+
+func TestPickupSystem_FullHoldLeavesPickup(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	ps := NewPickupSystem(w)
+	player := fullHoldPlayer()
+	player.AABB.X, player.AABB.Y = 128, 500
+	centerX, centerY := playerCenter(player)
+
+	pickup := ps.SpawnOre(entities.OreDiamond, centerX, centerY)
+	pickup.CollectDelay = 0
+	ps.UpdatePickups(player, 1.0/60.0)
+
+	if player.OreInventory[entities.OreDiamond] != 0 {
+		t.Error("Full hold should not take the pickup")
+	}
+	if len(ps.GetPickups()) != 1 {
+		t.Errorf("Pickup should stay in the world, got %d", len(ps.GetPickups()))
+	}
+}
+
+func TestPickupSystem_SpawnDroppedOre(t *testing.T) {
+	w := world.NewWorld(7680, 64000, 640, 42)
+	ps := NewPickupSystem(w)
+
+	ps.SpawnDroppedOre([]DroppedOre{
+		{X: 100, Y: 200, OreType: entities.OreCopper},
+		{X: 300, Y: 200, OreType: entities.OreIron},
+	})
+
+	pickups := ps.GetPickups()
+	if len(pickups) != 2 {
+		t.Fatalf("Expected 2 pickups, got %d", len(pickups))
+	}
+	if pickups[1].OreType != entities.OreIron || pickups[1].AABB.X != 300-entities.PickupSize/2 {
+		t.Errorf("Pickup should be centered on the drop position, got %+v", pickups[1])
+	}
+}
+
+func TestPickupSystem_JettisonedOreWaitsForPlayerToMoveOff(t *testing.T) {
+	w := world.NewWorld(1280, 720, 640, 42)
+	ps := NewPickupSystem(w)
+	cs := NewCargoSystem()
+	player := entities.NewPlayer(128, 0)
+	player.AABB.Y = 640 - player.AABB.Height
+	player.AddOre(entities.OreGold)
+
+	cs.ProcessCargo(player, input.InputState{JettisonOre: int(entities.OreGold) + 1})
+	ps.SpawnDroppedOre(cs.TakeDroppedOre())
+
+	// Standing still well past the collect delay: the ore rests at the player's feet
+	settlePickups(ps, player, 120)
+	if player.OreInventory[entities.OreGold] != 0 || len(ps.GetPickups()) != 1 {
+		t.Fatalf("Jettisoned ore should not be re-collected by a stationary player, got gold=%d pickups=%d",
+			player.OreInventory[entities.OreGold], len(ps.GetPickups()))
+	}
+
+	// Moving off it once makes it collectable on the way back
+	player.AABB.X += 200
+	settlePickups(ps, player, 1)
+	player.AABB.X -= 200
+	settlePickups(ps, player, 1)
+	if player.OreInventory[entities.OreGold] != 1 {
+		t.Errorf("Ore should be collected after the player moved off and back, got %d", player.OreInventory[entities.OreGold])
+	}
+}
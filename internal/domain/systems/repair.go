@@ -0,0 +1,34 @@
+package systems
+
+import (
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+)
+
+type RepairSystem struct {
+	repairShop *entities.RepairShop
+}
+
+func NewRepairSystem(repairShop *entities.RepairShop) *RepairSystem {
+	return &RepairSystem{repairShop: repairShop}
+}
+
+// ProcessRepair restores worn components when the player interacts with the repair shop
+func (rs *RepairSystem) ProcessRepair(
+	player *entities.Player,
+	inputState input.InputState,
+) {
+	if !inputState.Sell {
+		return
+	}
+
+	if !rs.repairShop.IsPlayerInRange(player) {
+		return
+	}
+
+	player.Repair()
+}
+
+func (rs *RepairSystem) GetRepairShop() *entities.RepairShop {
+	return rs.repairShop
+}
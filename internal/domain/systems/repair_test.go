@@ -0,0 +1,51 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+	"github.com/Kishlin/drill-game/internal/domain/input"
+)
+
+func TestRepairSystem_ProcessRepair_RestoresWornComponents(t *testing.T) {
+	player := entities.NewPlayer(100, 100)
+	player.WearComponents(0.5, entities.SlotEngine, entities.SlotDrill)
+	cost := player.RepairCost()
+	initialMoney := player.Money
+
+	system := NewRepairSystem(entities.NewRepairShop(80, 80))
+	system.ProcessRepair(player, input.InputState{Sell: true})
+
+	if player.Money != initialMoney-cost {
+		t.Errorf("Expected money %d, got %d", initialMoney-cost, player.Money)
+	}
+	if player.Engine().Condition() != 1 || player.Drill().Condition() != 1 {
+		t.Error("Expected engine and drill to be repaired")
+	}
+}
+
+func TestRepairSystem_ProcessRepair_NoSellInput(t *testing.T) {
+	player := entities.NewPlayer(100, 100)
+	player.WearComponents(0.5, entities.SlotEngine)
+	initialMoney := player.Money
+
+	system := NewRepairSystem(entities.NewRepairShop(80, 80))
+	system.ProcessRepair(player, input.InputState{})
+
+	if player.Money != initialMoney || player.Engine().Condition() != 0.5 {
+		t.Error("Nothing should happen without the sell input")
+	}
+}
+
+func TestRepairSystem_ProcessRepair_OutOfRange(t *testing.T) {
+	player := entities.NewPlayer(5000, 5000)
+	player.WearComponents(0.5, entities.SlotEngine)
+	initialMoney := player.Money
+
+	system := NewRepairSystem(entities.NewRepairShop(80, 80))
+	system.ProcessRepair(player, input.InputState{Sell: true})
+
+	if player.Money != initialMoney || player.Engine().Condition() != 0.5 {
+		t.Error("Nothing should happen out of range")
+	}
+}
@@ -6,29 +6,12 @@ import (
 )
 
 type UpgradeSystem struct {
-	engineShop     *entities.EngineUpgradeShop
-	hullShop       *entities.HullUpgradeShop
-	fuelTankShop   *entities.FuelTankUpgradeShop
-	cargoHoldShop  *entities.CargoHoldUpgradeShop
-	heatShieldShop *entities.HeatShieldUpgradeShop
-	drillShop      *entities.DrillUpgradeShop
+	shops []*entities.UpgradeShop
 }
 
-func NewUpgradeSystem(
-	engineShop *entities.EngineUpgradeShop,
-	hullShop *entities.HullUpgradeShop,
-	fuelTankShop *entities.FuelTankUpgradeShop,
-	cargoHoldShop *entities.CargoHoldUpgradeShop,
-	heatShieldShop *entities.HeatShieldUpgradeShop,
-	drillShop *entities.DrillUpgradeShop,
-) *UpgradeSystem {
+func NewUpgradeSystem(shops ...*entities.UpgradeShop) *UpgradeSystem {
 	return &UpgradeSystem{
-		engineShop:     engineShop,
-		hullShop:       hullShop,
-		fuelTankShop:   fuelTankShop,
-		cargoHoldShop:  cargoHoldShop,
-		heatShieldShop: heatShieldShop,
-		drillShop:      drillShop,
+		shops: shops,
 	}
 }
 
@@ -36,134 +19,66 @@ func (us *UpgradeSystem) ProcessUpgrade(
 	player *entities.Player,
 	inputState input.InputState,
 ) {
-	if !inputState.Sell {
+	if !inputState.Sell && !inputState.SellBack {
 		return
 	}
 
-	if us.engineShop.IsPlayerInRange(player) {
-		us.tryUpgradeEngine(player)
-		return
-	}
-	if us.hullShop.IsPlayerInRange(player) {
-		us.tryUpgradeHull(player)
-		return
-	}
-	if us.fuelTankShop.IsPlayerInRange(player) {
-		us.tryUpgradeFuelTank(player)
-		return
-	}
-	if us.cargoHoldShop.IsPlayerInRange(player) {
-		us.tryUpgradeCargoHold(player)
-		return
-	}
-	if us.heatShieldShop.IsPlayerInRange(player) {
-		us.tryUpgradeHeatShield(player)
-		return
-	}
-	if us.drillShop.IsPlayerInRange(player) {
-		us.tryUpgradeDrill(player)
+	for _, shop := range us.shops {
+		if !shop.IsPlayerInRange(player) {
+			continue
+		}
+		if inputState.Sell {
+			us.tryUpgrade(player, shop)
+		} else {
+			us.trySellBack(player, shop)
+		}
 		return
 	}
 }
 
-func (us *UpgradeSystem) tryUpgradeEngine(player *entities.Player) {
-	entry := us.engineShop.GetNextEngine(player.Engine.Tier())
+// tryUpgrade buys the tier above the one installed in the shop's slot, trading the installed one in
+func (us *UpgradeSystem) tryUpgrade(player *entities.Player, shop *entities.UpgradeShop) {
+	entry := shop.GetNext(player.Loadout[shop.Slot].Tier())
 	if entry == nil {
 		return // Max level reached
 	}
 
-	if !player.CanAfford(entry.Price) {
+	credit := us.TradeInCredit(player, shop)
+	if !player.CanAfford(entry.Price - credit) {
 		return
 	}
 
-	player.BuyEngine(entry.Engine, entry.Price)
+	player.BuyComponent(entry.Component, entry.Price, credit)
 }
 
-func (us *UpgradeSystem) tryUpgradeHull(player *entities.Player) {
-	entry := us.hullShop.GetNextHull(player.Hull.Tier())
-	if entry == nil {
-		return // Max level reached
+// trySellBack downgrades the shop's slot by one tier and refunds part of the installed component's value
+func (us *UpgradeSystem) trySellBack(player *entities.Player, shop *entities.UpgradeShop) {
+	previous, ok := shop.GetPrevious(player.Loadout[shop.Slot].Tier())
+	if !ok {
+		return // Base components can't be sold
 	}
 
-	if !player.CanAfford(entry.Price) {
-		return
-	}
-
-	player.BuyHull(entry.Hull, entry.Price)
+	player.SellComponent(previous, us.ResaleValue(player, shop))
 }
 
-func (us *UpgradeSystem) tryUpgradeFuelTank(player *entities.Player) {
-	entry := us.fuelTankShop.GetNextFuelTank(player.FuelTank.Tier())
-	if entry == nil {
-		return // Max level reached
-	}
-
-	if !player.CanAfford(entry.Price) {
-		return
-	}
-
-	player.BuyFuelTank(entry.FuelTank, entry.Price)
-}
-
-func (us *UpgradeSystem) tryUpgradeCargoHold(player *entities.Player) {
-	entry := us.cargoHoldShop.GetNextCargoHold(player.CargoHold.Tier())
-	if entry == nil {
-		return // Max level reached
-	}
-
-	if !player.CanAfford(entry.Price) {
-		return
-	}
-
-	player.BuyCargoHold(entry.CargoHold, entry.Price)
+// TradeInCredit returns what the installed component is worth toward the shop's next tier
+func (us *UpgradeSystem) TradeInCredit(player *entities.Player, shop *entities.UpgradeShop) int {
+	return int(float32(installedValue(player, shop)) * entities.TradeInRate)
 }
 
-func (us *UpgradeSystem) tryUpgradeHeatShield(player *entities.Player) {
-	entry := us.heatShieldShop.GetNextHeatShield(player.HeatShield.Tier())
-	if entry == nil {
-		return // Max level reached
-	}
-
-	if !player.CanAfford(entry.Price) {
-		return
-	}
-
-	player.BuyHeatShield(entry.HeatShield, entry.Price)
+// ResaleValue returns what selling the installed component back to the shop refunds
+func (us *UpgradeSystem) ResaleValue(player *entities.Player, shop *entities.UpgradeShop) int {
+	return int(float32(installedValue(player, shop)) * entities.ResaleRate)
 }
 
-func (us *UpgradeSystem) tryUpgradeDrill(player *entities.Player) {
-	entry := us.drillShop.GetNextDrill(player.Drill.Tier())
-	if entry == nil {
-		return // Max level reached
+// installedValue is the price paid for the installed component, or its catalog price if it wasn't bought
+func installedValue(player *entities.Player, shop *entities.UpgradeShop) int {
+	if price, ok := player.PaidPrice(shop.Slot); ok {
+		return price
 	}
-
-	if !player.CanAfford(entry.Price) {
-		return
-	}
-
-	player.BuyDrill(entry.Drill, entry.Price)
-}
-
-func (us *UpgradeSystem) GetEngineShop() *entities.EngineUpgradeShop {
-	return us.engineShop
-}
-
-func (us *UpgradeSystem) GetHullShop() *entities.HullUpgradeShop {
-	return us.hullShop
-}
-
-func (us *UpgradeSystem) GetFuelTankShop() *entities.FuelTankUpgradeShop {
-	return us.fuelTankShop
-}
-
-func (us *UpgradeSystem) GetCargoHoldShop() *entities.CargoHoldUpgradeShop {
-	return us.cargoHoldShop
-}
-
-func (us *UpgradeSystem) GetHeatShieldShop() *entities.HeatShieldUpgradeShop {
-	return us.heatShieldShop
+	return shop.ValueOf(player.Loadout[shop.Slot].Tier())
 }
 
-func (us *UpgradeSystem) GetDrillShop() *entities.DrillUpgradeShop {
-	return us.drillShop
+func (us *UpgradeSystem) GetShops() []*entities.UpgradeShop {
+	return us.shops
 }
@@ -9,15 +9,13 @@ import (
 )
 
 func createTestUpgradeSystem() (*systems.UpgradeSystem, *entities.Player) {
-	// Create shops at positions where player (at 0,0) will be in range
-	engineShop := entities.NewEngineUpgradeShop(0, 0)
-	hullShop := entities.NewHullUpgradeShop(400, 0)
-	fuelTankShop := entities.NewFuelTankUpgradeShop(800, 0)
-	cargoHoldShop := entities.NewCargoHoldUpgradeShop(1200, 0)
-	heatShieldShop := entities.NewHeatShieldUpgradeShop(1600, 0)
-	drillShop := entities.NewDrillUpgradeShop(2000, 0)
-
-	system := systems.NewUpgradeSystem(engineShop, hullShop, fuelTankShop, cargoHoldShop, heatShieldShop, drillShop)
+	// One shop per line every 400px (engine at 0, where the player starts)
+	shops := make([]*entities.UpgradeShop, 0, len(entities.ComponentLines))
+	for i, line := range entities.ComponentLines {
+		shops = append(shops, entities.NewUpgradeShop(line, float32(i)*400, 0))
+	}
+
+	system := systems.NewUpgradeSystem(shops...)
 	player := entities.NewPlayer(0, 0)
 
 	return system, player
@@ -30,8 +28,8 @@ func TestUpgradeSystem_BuyEngineMk1_Success(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Engine.Tier() != 1 {
-		t.Errorf("Expected engine tier 1, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 1 {
+		t.Errorf("Expected engine tier 1, got %d", player.Engine().Tier())
 	}
 	if player.Money != 100 {
 		t.Errorf("Expected money to be 100 after purchase, got %d", player.Money)
@@ -45,8 +43,8 @@ func TestUpgradeSystem_BuyEngine_InsufficientFunds(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Engine.Tier() != 0 {
-		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 0 {
+		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine().Tier())
 	}
 	if player.Money != 50 {
 		t.Errorf("Expected money to remain 50, got %d", player.Money)
@@ -60,8 +58,8 @@ func TestUpgradeSystem_BuyEngine_NoInput(t *testing.T) {
 	inputState := input.InputState{Sell: false}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Engine.Tier() != 0 {
-		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 0 {
+		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine().Tier())
 	}
 }
 
@@ -74,8 +72,8 @@ func TestUpgradeSystem_BuyEngine_OutOfRange(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Engine.Tier() != 0 {
-		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 0 {
+		t.Errorf("Expected engine tier to remain 0, got %d", player.Engine().Tier())
 	}
 }
 
@@ -90,16 +88,16 @@ func TestUpgradeSystem_BuyEngine_MaxLevel(t *testing.T) {
 		system.ProcessUpgrade(player, inputState)
 	}
 
-	if player.Engine.Tier() != 5 {
-		t.Errorf("Expected engine tier 5, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 5 {
+		t.Errorf("Expected engine tier 5, got %d", player.Engine().Tier())
 	}
 
 	initialMoney := player.Money
 	// Try to buy again at max level
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Engine.Tier() != 5 {
-		t.Errorf("Expected engine tier to remain 5, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 5 {
+		t.Errorf("Expected engine tier to remain 5, got %d", player.Engine().Tier())
 	}
 	if player.Money != initialMoney {
 		t.Errorf("Expected money to remain unchanged at max level")
@@ -115,8 +113,8 @@ func TestUpgradeSystem_BuyHull_Success(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.Hull.Tier() != 1 {
-		t.Errorf("Expected hull tier 1, got %d", player.Hull.Tier())
+	if player.Hull().Tier() != 1 {
+		t.Errorf("Expected hull tier 1, got %d", player.Hull().Tier())
 	}
 	if player.Money != 50 { // Hull Mk1 costs $150
 		t.Errorf("Expected money to be 50 after purchase, got %d", player.Money)
@@ -132,14 +130,34 @@ func TestUpgradeSystem_BuyFuelTank_Success(t *testing.T) {
 	inputState := input.InputState{Sell: true}
 	system.ProcessUpgrade(player, inputState)
 
-	if player.FuelTank.Tier() != 1 {
-		t.Errorf("Expected fuel tank tier 1, got %d", player.FuelTank.Tier())
+	if player.FuelTank().Tier() != 1 {
+		t.Errorf("Expected fuel tank tier 1, got %d", player.FuelTank().Tier())
 	}
 	if player.Money != 100 { // Tank Mk1 costs $100
 		t.Errorf("Expected money to be 100 after purchase, got %d", player.Money)
 	}
 }
 
+func TestUpgradeSystem_BuyOreDetector_Success(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.Money = 500
+	// Move player to ore detector shop position
+	player.AABB.X = 2400
+
+	inputState := input.InputState{Sell: true}
+	system.ProcessUpgrade(player, inputState)
+
+	if player.OreDetector().Tier() != 1 {
+		t.Errorf("Expected ore detector tier 1, got %d", player.OreDetector().Tier())
+	}
+	if player.Money != 200 { // Ore Detector Mk1 costs $300
+		t.Errorf("Expected money to be 200 after purchase, got %d", player.Money)
+	}
+	if player.OreDetector().Stat(entities.StatScanRadius) <= entities.NewComponent(entities.SlotOreDetector, 0).Stat(entities.StatScanRadius) {
+		t.Errorf("Mk1 should scan further than the base detector")
+	}
+}
+
 func TestUpgradeSystem_ProgressiveUpgrades(t *testing.T) {
 	system, player := createTestUpgradeSystem()
 	player.Money = 10000 // Plenty for multiple upgrades
@@ -148,19 +166,128 @@ func TestUpgradeSystem_ProgressiveUpgrades(t *testing.T) {
 
 	// Buy Mk1
 	system.ProcessUpgrade(player, inputState)
-	if player.Engine.Tier() != 1 {
-		t.Errorf("Expected engine tier 1 after first purchase, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 1 {
+		t.Errorf("Expected engine tier 1 after first purchase, got %d", player.Engine().Tier())
 	}
 
 	// Buy Mk2
 	system.ProcessUpgrade(player, inputState)
-	if player.Engine.Tier() != 2 {
-		t.Errorf("Expected engine tier 2 after second purchase, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 2 {
+		t.Errorf("Expected engine tier 2 after second purchase, got %d", player.Engine().Tier())
 	}
 
 	// Buy Mk3
 	system.ProcessUpgrade(player, inputState)
-	if player.Engine.Tier() != 3 {
-		t.Errorf("Expected engine tier 3 after third purchase, got %d", player.Engine.Tier())
+	if player.Engine().Tier() != 3 {
+		t.Errorf("Expected engine tier 3 after third purchase, got %d", player.Engine().Tier())
+	}
+}
+
+func TestUpgradeSystem_TradeInCreditsInstalledComponent(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.Money = 1000
+
+	inputState := input.InputState{Sell: true}
+	system.ProcessUpgrade(player, inputState) // Mk1 for $100
+	system.ProcessUpgrade(player, inputState) // Mk2 for $300, Mk1 traded in for $50
+
+	if player.Engine().Tier() != 2 {
+		t.Fatalf("Expected engine tier 2, got %d", player.Engine().Tier())
+	}
+	if player.Money != 1000-100-(300-50) {
+		t.Errorf("Expected money 650 after trade-in, got %d", player.Money)
+	}
+	if price, ok := player.PaidPrice(entities.SlotEngine); !ok || price != 300 {
+		t.Errorf("Expected Mk2 recorded at its $300 price, got %d (%v)", price, ok)
+	}
+}
+
+func TestUpgradeSystem_TradeInMakesUpgradeAffordable(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	// Mk1 installed without a purchase is valued at its $100 catalog price
+	player.Loadout[entities.SlotEngine] = entities.NewComponent(entities.SlotEngine, 1)
+	player.Money = 250 // Mk2 costs $300 - $50
+
+	system.ProcessUpgrade(player, input.InputState{Sell: true})
+
+	if player.Engine().Tier() != 2 {
+		t.Errorf("Trade-in should cover the shortfall, got tier %d", player.Engine().Tier())
+	}
+	if player.Money != 0 {
+		t.Errorf("Expected money 0, got %d", player.Money)
+	}
+}
+
+func TestUpgradeSystem_SellBackDowngradesAndRefunds(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.Money = 1000
+
+	system.ProcessUpgrade(player, input.InputState{Sell: true}) // Mk1 for $100
+	system.ProcessUpgrade(player, input.InputState{Sell: true}) // Mk2 for $250 net
+	system.ProcessUpgrade(player, input.InputState{SellBack: true})
+
+	if player.Engine().Tier() != 1 {
+		t.Fatalf("Expected engine tier 1 after selling back, got %d", player.Engine().Tier())
+	}
+	if player.Money != 650+90 { // 30% of the $300 paid
+		t.Errorf("Expected money 740 after resale, got %d", player.Money)
+	}
+	if price, ok := player.PaidPrice(entities.SlotEngine); !ok || price != 100 {
+		t.Errorf("Mk1's purchase should be the installed one again, got %d (%v)", price, ok)
+	}
+}
+
+func TestUpgradeSystem_SellBackKeepsWear(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.Loadout[entities.SlotEngine] = entities.NewComponent(entities.SlotEngine, 2).Worn(0.4)
+
+	system.ProcessUpgrade(player, input.InputState{SellBack: true})
+
+	if player.Engine().Tier() != 1 {
+		t.Fatalf("Expected engine tier 1 after selling back, got %d", player.Engine().Tier())
+	}
+	if condition := player.Loadout[entities.SlotEngine].Condition(); condition < 0.599 || condition > 0.601 {
+		t.Errorf("Replacement should keep the sold engine's wear (condition 0.6), got %f", condition)
+	}
+}
+
+func TestUpgradeSystem_SellBackBaseDoesNothing(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	money := player.Money
+
+	system.ProcessUpgrade(player, input.InputState{SellBack: true})
+
+	if player.Engine().Tier() != 0 || player.Money != money {
+		t.Errorf("Base engine shouldn't be sellable, got tier %d and $%d", player.Engine().Tier(), player.Money)
+	}
+}
+
+func TestUpgradeSystem_SellBackHullClampsHP(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.AABB.X = 400 // Hull shop
+	player.Loadout[entities.SlotHull] = entities.NewComponent(entities.SlotHull, 3)
+	player.HP = player.Hull().MaxHP()
+
+	system.ProcessUpgrade(player, input.InputState{SellBack: true})
+
+	if player.Hull().Tier() != 2 {
+		t.Fatalf("Expected hull tier 2, got %d", player.Hull().Tier())
+	}
+	if player.HP != player.Hull().MaxHP() {
+		t.Errorf("HP should be clamped to the smaller hull's %f, got %f", player.Hull().MaxHP(), player.HP)
+	}
+}
+
+func TestUpgradeSystem_SellBackCargoHoldNeedsRoomForOre(t *testing.T) {
+	system, player := createTestUpgradeSystem()
+	player.AABB.X = 1200 // Cargo hold shop
+	player.Loadout[entities.SlotCargoHold] = entities.NewComponent(entities.SlotCargoHold, 1)
+	player.OreInventory[entities.OreCopper] = player.CargoHold().Capacity() // 14 ore, base holds 10
+	money := player.Money
+
+	system.ProcessUpgrade(player, input.InputState{SellBack: true})
+
+	if player.CargoHold().Tier() != 1 || player.Money != money {
+		t.Errorf("Sale should be refused while the ore wouldn't fit, got tier %d and $%d", player.CargoHold().Tier(), player.Money)
 	}
 }
@@ -0,0 +1,63 @@
+package world
+
+import "math"
+
+const (
+	DefaultBaseTemperature  = 15.0   // Temperature at ground level (°C)
+	DefaultMaxTemperature   = 350.0  // Temperature at max depth (°C)
+	DefaultBasePressure     = 1.0    // Pressure at ground level (atm)
+	DefaultMaxPressure      = 1000.0 // Pressure at max depth (atm)
+	DefaultSurfaceAmplitude = 4.0    // Tiles of hills/valleys around ground level for the game world
+)
+
+// Config is the single source of truth for world dimensions and every
+// value derived from depth (temperature, pressure, drilling difficulty, camera bounds)
+type Config struct {
+	Width           float32 // World width (pixels)
+	Height          float32 // World height (pixels)
+	GroundLevel     float32 // Surface Y position (pixels)
+	MaxDepth        float32 // Y position where depth-derived values peak (pixels)
+	BaseTemperature float32 // Temperature at ground level (°C)
+	MaxTemperature  float32 // Temperature at MaxDepth (°C)
+	BasePressure    float32 // Pressure at ground level (atm)
+	MaxPressure     float32 // Pressure at MaxDepth (atm)
+
+	// Tiles the surface may rise above or sink below GroundLevel (0 = flat ground row)
+	// GroundLevel stays the reference for depth-derived values
+	SurfaceAmplitude float32
+}
+
+// NewConfig creates a flat-surfaced config whose max depth is the bottom of the world
+func NewConfig(width, height, groundLevel float32) Config {
+	return Config{
+		Width:           width,
+		Height:          height,
+		GroundLevel:     groundLevel,
+		MaxDepth:        height,
+		BaseTemperature: DefaultBaseTemperature,
+		MaxTemperature:  DefaultMaxTemperature,
+		BasePressure:    DefaultBasePressure,
+		MaxPressure:     DefaultMaxPressure,
+	}
+}
+
+// NormalizedDepth returns how far y lies between ground level (0) and max depth (1)
+// Positions at or above ground return 0; positions below MaxDepth exceed 1
+func (c Config) NormalizedDepth(y float32) float32 {
+	depthBelowGround := y - c.GroundLevel
+	if depthBelowGround <= 0 {
+		return 0
+	}
+
+	maxDepth := c.MaxDepth - c.GroundLevel
+	if maxDepth <= 0 {
+		return 1
+	}
+
+	return depthBelowGround / maxDepth
+}
+
+// HighestSurfaceY returns the smallest Y the surface can reach (top of the tallest possible hill)
+func (c Config) HighestSurfaceY() float32 {
+	return c.GroundLevel - float32(math.Round(float64(c.SurfaceAmplitude)))*TileSize
+}
@@ -0,0 +1,46 @@
+package world
+
+import "testing"
+
+func TestNewConfig_MaxDepthIsWorldBottom(t *testing.T) {
+	cfg := NewConfig(7680, 51200, 640)
+
+	if cfg.MaxDepth != 51200 {
+		t.Errorf("Expected max depth 51200, got %f", cfg.MaxDepth)
+	}
+	if cfg.BaseTemperature != DefaultBaseTemperature || cfg.MaxTemperature != DefaultMaxTemperature {
+		t.Errorf("Expected default temperature curve, got %f..%f", cfg.BaseTemperature, cfg.MaxTemperature)
+	}
+}
+
+func TestConfig_NormalizedDepth(t *testing.T) {
+	cfg := NewConfig(7680, 64000, 640)
+
+	tests := []struct {
+		y        float32
+		expected float32
+	}{
+		{0, 0},       // Sky
+		{640, 0},     // Ground level
+		{32320, 0.5}, // Halfway to max depth
+		{64000, 1},   // Max depth
+	}
+
+	for _, test := range tests {
+		if got := cfg.NormalizedDepth(test.y); got != test.expected {
+			t.Errorf("Y=%f: expected normalized depth %f, got %f", test.y, test.expected, got)
+		}
+	}
+}
+
+func TestNewWorldFromConfig_ExposesConfig(t *testing.T) {
+	cfg := NewConfig(1280, 12800, 320)
+	w := NewWorldFromConfig(cfg, 42)
+
+	if w.GetConfig() != cfg {
+		t.Errorf("World should expose the config it was built from")
+	}
+	if w.GetGroundLevel() != 320 || w.Width != 1280 || w.Height != 12800 {
+		t.Errorf("World dimensions should come from config")
+	}
+}
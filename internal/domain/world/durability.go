@@ -0,0 +1,116 @@
+package world
+
+import "github.com/Kishlin/drill-game/internal/domain/entities"
+
+// Tile hit points are measured in base-drill seconds: a base drill removes 1 HP per second
+const (
+	MinTileHitPoints = 1.0  // Dirt at ground level
+	MaxTileHitPoints = 24.0 // Dirt at max depth
+
+	defaultOreHardness = 1.5 // Fallback for ore types missing from entities.OreHardness
+)
+
+// TileMaxHitPoints returns the full durability of the tile at grid coordinates
+// Scales linearly from MinTileHitPoints at ground level to MaxTileHitPoints at max depth,
+// multiplied by ore hardness. Returns 0 when there is no drillable tile
+func (w *World) TileMaxHitPoints(gridX, gridY int) float32 {
+	tile := w.GetTileAtGrid(gridX, gridY)
+	if tile == nil || !tile.IsDrillable() {
+		return 0
+	}
+
+	return w.hitPointsFor(float32(gridY)*TileSize, tile)
+}
+
+// hitPointsFor computes full durability for a tile whose top edge is at pixel Y
+func (w *World) hitPointsFor(tileY float32, tile *entities.Tile) float32 {
+	normalizedDepth := w.NormalizedDepth(tileY)
+
+	// Clamp normalized depth to [0, 1] in case tile exceeds the configured max depth
+	if normalizedDepth > 1.0 {
+		normalizedDepth = 1.0
+	}
+
+	hitPoints := MinTileHitPoints + normalizedDepth*(MaxTileHitPoints-MinTileHitPoints)
+
+	if tile.Type == entities.TileTypeOre {
+		hardness, ok := entities.OreHardness[tile.OreType]
+		if !ok {
+			hardness = defaultOreHardness
+		}
+		hitPoints *= hardness
+	}
+
+	return hitPoints
+}
+
+// TileHitPoints returns the remaining durability of the tile at grid coordinates
+func (w *World) TileHitPoints(gridX, gridY int) float32 {
+	maxHitPoints := w.TileMaxHitPoints(gridX, gridY)
+	if maxHitPoints == 0 {
+		return 0
+	}
+
+	remaining := maxHitPoints - w.damage[[2]int{gridX, gridY}]
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// TileDamageRatio returns how much of the tile has been worn away (0 = intact, 1 = destroyed)
+// Used to resume cancelled drills and to draw cracks on damaged tiles
+func (w *World) TileDamageRatio(gridX, gridY int) float32 {
+	damage := w.damage[[2]int{gridX, gridY}]
+	if damage == 0 {
+		return 0 // Most tiles are intact: skip the hit point calculation
+	}
+
+	maxHitPoints := w.TileMaxHitPoints(gridX, gridY)
+	if maxHitPoints == 0 {
+		return 0
+	}
+
+	ratio := damage / maxHitPoints
+	if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
+
+// DamageTileAtGrid wears down the tile at grid coordinates by amount hit points
+// When durability runs out the tile is removed and returned, like DrillTileAtGrid
+func (w *World) DamageTileAtGrid(gridX, gridY int, amount float32) (*entities.Tile, bool) {
+	maxHitPoints := w.TileMaxHitPoints(gridX, gridY)
+	if maxHitPoints == 0 || amount <= 0 {
+		return nil, false
+	}
+
+	key := [2]int{gridX, gridY}
+	w.damage[key] += amount
+
+	if w.damage[key] < maxHitPoints {
+		return nil, false
+	}
+
+	return w.DrillTileAtGrid(gridX, gridY)
+}
+
+// GetTileDamage returns a copy of all accumulated tile damage keyed by grid coordinates (for saving)
+// Damage is kept apart from generated tiles so it survives chunks being regenerated
+func (w *World) GetTileDamage() map[[2]int]float32 {
+	damage := make(map[[2]int]float32, len(w.damage))
+	for key, amount := range w.damage {
+		damage[key] = amount
+	}
+	return damage
+}
+
+// SetTileDamage restores accumulated damage on a tile (for loading)
+func (w *World) SetTileDamage(gridX, gridY int, amount float32) {
+	if amount <= 0 {
+		delete(w.damage, [2]int{gridX, gridY})
+		return
+	}
+	w.damage[[2]int{gridX, gridY}] = amount
+}
@@ -0,0 +1,101 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+)
+
+func TestTileMaxHitPoints_DepthScaling(t *testing.T) {
+	world := NewWorld(7680, 64000, 640, 42)
+
+	depthTests := []struct {
+		tileGridY int
+		minExpect float32
+		maxExpect float32
+	}{
+		{10, 0.9, 1.1},    // Near ground (Y=640): ~1.0 HP
+		{500, 12.0, 13.0}, // Mid-depth (Y=32000): ~12.4 HP
+		{990, 23.5, 24.5}, // Deep (Y=63360): ~24 HP
+	}
+
+	for _, test := range depthTests {
+		world.GetTileAtGrid(5, test.tileGridY) // Load the chunk first so generation does not overwrite
+		world.SetTile(5, test.tileGridY, entities.NewTile(entities.TileTypeDirt))
+		hitPoints := world.TileMaxHitPoints(5, test.tileGridY)
+
+		if hitPoints < test.minExpect || hitPoints > test.maxExpect {
+			t.Errorf("Grid Y=%d: expected ~[%f, %f] HP, got %f",
+				test.tileGridY, test.minExpect, test.maxExpect, hitPoints)
+		}
+	}
+}
+
+func TestTileMaxHitPoints_FollowsWorldDepth(t *testing.T) {
+	// 800-tile world: the bottom row should already have max hit points
+	world := NewWorld(7680, 51200, 640, 42)
+	world.GetTileAtGrid(5, 800)
+	world.SetTile(5, 800, entities.NewTile(entities.TileTypeDirt))
+
+	if hitPoints := world.TileMaxHitPoints(5, 800); hitPoints != MaxTileHitPoints {
+		t.Errorf("Expected %f HP at the bottom of a 51200px world, got %f", float32(MaxTileHitPoints), hitPoints)
+	}
+}
+
+func TestTileMaxHitPoints_AppliesOreHardness(t *testing.T) {
+	world := NewWorld(7680, 64000, 640, 42)
+	world.GetTileAtGrid(5, 10)
+	world.SetTile(5, 10, entities.NewOreTile(entities.OreDiamond))
+
+	// Ground level dirt is 1 HP, diamond is 3x harder
+	if hitPoints := world.TileMaxHitPoints(5, 10); hitPoints != 3.0 {
+		t.Errorf("Expected 3.0 HP for diamond at ground level, got %f", hitPoints)
+	}
+}
+
+func TestDamageTileAtGrid_AccumulatesUntilDestroyed(t *testing.T) {
+	world := NewWorld(7680, 64000, 640, 42)
+	world.GetTileAtGrid(5, 10)
+	world.SetTile(5, 10, entities.NewOreTile(entities.OreDiamond)) // 3 HP
+
+	if _, destroyed := world.DamageTileAtGrid(5, 10, 1.0); destroyed {
+		t.Fatal("Tile should survive partial damage")
+	}
+	if hitPoints := world.TileHitPoints(5, 10); hitPoints != 2.0 {
+		t.Errorf("Expected 2.0 HP remaining, got %f", hitPoints)
+	}
+	if ratio := world.TileDamageRatio(5, 10); ratio < 0.33 || ratio > 0.34 {
+		t.Errorf("Expected ~1/3 damage ratio, got %f", ratio)
+	}
+
+	tile, destroyed := world.DamageTileAtGrid(5, 10, 2.5)
+	if !destroyed || tile == nil || tile.OreType != entities.OreDiamond {
+		t.Fatal("Tile should be destroyed and returned once its hit points run out")
+	}
+	if world.GetTileAtGrid(5, 10) != nil {
+		t.Error("Destroyed tile should be removed from the world")
+	}
+	if len(world.GetTileDamage()) != 0 {
+		t.Error("Damage on a destroyed tile should be cleared")
+	}
+}
+
+func TestTileDamage_SaveAndRestore(t *testing.T) {
+	world := NewWorld(7680, 64000, 640, 42)
+	world.GetTileAtGrid(5, 10)
+	world.SetTile(5, 10, entities.NewTile(entities.TileTypeDirt))
+	world.DamageTileAtGrid(5, 10, 0.25)
+
+	saved := world.GetTileDamage()
+
+	restored := NewWorld(7680, 64000, 640, 42)
+	restored.GetTileAtGrid(5, 10)
+	restored.SetTile(5, 10, entities.NewTile(entities.TileTypeDirt))
+	for key, amount := range saved {
+		restored.SetTileDamage(key[0], key[1], amount)
+	}
+
+	if hitPoints := restored.TileHitPoints(5, 10); hitPoints != 0.75 {
+		t.Errorf("Expected restored tile to have 0.75 HP, got %f", hitPoints)
+	}
+}
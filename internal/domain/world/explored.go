@@ -0,0 +1,90 @@
+package world
+
+// ExploredMask is a per-chunk bitset of seen cells (bit index = localY*ChunkSize + localX)
+// It is a plain value so it can be copied out for saving and restored on load
+type ExploredMask [ChunkSize * ChunkSize / 64]uint64
+
+// Has reports whether the cell at chunk-local coordinates has been seen
+func (m ExploredMask) Has(localX, localY int) bool {
+	bit := localY*ChunkSize + localX
+	return m[bit/64]&(1<<(bit%64)) != 0
+}
+
+// Set marks the cell at chunk-local coordinates as seen
+func (m *ExploredMask) Set(localX, localY int) {
+	bit := localY*ChunkSize + localX
+	m[bit/64] |= 1 << (bit % 64)
+}
+
+// MarkExplored records that the player has seen the cell at grid coordinates
+func (w *World) MarkExplored(gridX, gridY int) {
+	if gridX < 0 || gridY < 0 {
+		return
+	}
+
+	key := [2]int{gridX / ChunkSize, gridY / ChunkSize}
+	mask := w.explored[key]
+	mask.Set(gridX%ChunkSize, gridY%ChunkSize)
+	w.explored[key] = mask
+}
+
+// IsExplored reports whether the cell at grid coordinates has been seen
+// The sky above the surface is always visible
+func (w *World) IsExplored(gridX, gridY int) bool {
+	if gridY < w.SurfaceTileY(gridX) {
+		return true
+	}
+	if gridX < 0 {
+		return false
+	}
+
+	mask, ok := w.explored[[2]int{gridX / ChunkSize, gridY / ChunkSize}]
+	return ok && mask.Has(gridX%ChunkSize, gridY%ChunkSize)
+}
+
+// GetExploredChunks returns a copy of every chunk's explored mask (for saving)
+func (w *World) GetExploredChunks() map[[2]int]ExploredMask {
+	chunks := make(map[[2]int]ExploredMask, len(w.explored))
+	for key, mask := range w.explored {
+		chunks[key] = mask
+	}
+	return chunks
+}
+
+// SetExploredChunk restores a chunk's explored mask (for loading)
+func (w *World) SetExploredChunk(chunkX, chunkY int, mask ExploredMask) {
+	w.explored[[2]int{chunkX, chunkY}] = mask
+}
+
+// ForEachExploredCell visits every explored cell at or below the surface in the inclusive grid rectangle
+// tile is nil for explored empty space. Only chunks with explored cells are loaded,
+// so the cost scales with explored area rather than rectangle size (used by the map screen)
+func (w *World) ForEachExploredCell(minX, maxX, minY, maxY int, visit TileVisitor) {
+	for key, mask := range w.explored {
+		chunkMinX, chunkMinY := key[0]*ChunkSize, key[1]*ChunkSize
+		if chunkMinX > maxX || chunkMinX+ChunkSize-1 < minX ||
+			chunkMinY > maxY || chunkMinY+ChunkSize-1 < minY {
+			continue
+		}
+
+		w.EnsureChunkLoaded(key[0], key[1])
+
+		for localX := 0; localX < ChunkSize; localX++ {
+			for localY := 0; localY < ChunkSize; localY++ {
+				gridX, gridY := chunkMinX+localX, chunkMinY+localY
+				if gridX < minX || gridX > maxX || gridY < minY || gridY > maxY {
+					continue
+				}
+				if !mask.Has(localX, localY) {
+					continue
+				}
+				// The sky is always visible and never part of the explored terrain
+				if gridY < w.SurfaceTileY(gridX) {
+					continue
+				}
+
+				visit(gridX, gridY, w.tiles[[2]int{gridX, gridY}])
+			}
+		}
+	}
+}
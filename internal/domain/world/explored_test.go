@@ -0,0 +1,107 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+)
+
+func TestIsExplored_UndergroundStartsHidden(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	if w.IsExplored(5, 20) {
+		t.Error("Underground cells should start unexplored")
+	}
+}
+
+func TestIsExplored_SkyAlwaysVisible(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	// Ground row is 10 (640 / 64); everything above is sky
+	if !w.IsExplored(5, 9) {
+		t.Error("Sky cells should always count as explored")
+	}
+	if w.IsExplored(5, 10) {
+		t.Error("Ground row should start unexplored")
+	}
+}
+
+func TestMarkExplored_MarksSingleCell(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	w.MarkExplored(17, 33)
+
+	if !w.IsExplored(17, 33) {
+		t.Error("Marked cell should be explored")
+	}
+	if w.IsExplored(18, 33) || w.IsExplored(17, 34) {
+		t.Error("Neighbouring cells should stay unexplored")
+	}
+}
+
+func TestMarkExplored_IgnoresNegativeCoordinates(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	w.MarkExplored(-1, 20)
+
+	if len(w.GetExploredChunks()) != 0 {
+		t.Error("Negative coordinates should not create explored chunks")
+	}
+}
+
+func TestExploredChunks_RoundTrip(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+	w.MarkExplored(3, 20)
+	w.MarkExplored(40, 100)
+
+	saved := w.GetExploredChunks()
+
+	restored := NewWorld(7680, 64000, 640, 42)
+	for key, mask := range saved {
+		restored.SetExploredChunk(key[0], key[1], mask)
+	}
+
+	if !restored.IsExplored(3, 20) || !restored.IsExplored(40, 100) {
+		t.Error("Explored cells should survive a save/load round trip")
+	}
+	if restored.IsExplored(4, 20) {
+		t.Error("Unexplored cells should stay unexplored after restore")
+	}
+}
+
+func TestGetExploredChunks_ReturnsCopy(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+	w.MarkExplored(3, 20)
+
+	chunks := w.GetExploredChunks()
+	delete(chunks, [2]int{0, 1})
+
+	if !w.IsExplored(3, 20) {
+		t.Error("Mutating the returned map should not affect the world")
+	}
+}
+
+func TestForEachExploredCell_VisitsOnlyExplored(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+	w.EnsureChunkLoaded(0, 1)
+	w.SetTile(2, 20, entities.NewOreTile(entities.OreIron))
+	w.SetTile(3, 20, nil)
+	w.MarkExplored(2, 20)
+	w.MarkExplored(3, 20)
+	w.MarkExplored(100, 500) // Outside the queried rect
+
+	visited := map[[2]int]*entities.Tile{}
+	w.ForEachExploredCell(0, 10, 15, 25, func(gridX, gridY int, tile *entities.Tile) {
+		visited[[2]int{gridX, gridY}] = tile
+	})
+
+	if len(visited) != 2 {
+		t.Fatalf("Expected 2 explored cells, got %d", len(visited))
+	}
+	if tile := visited[[2]int{2, 20}]; tile == nil || tile.OreType != entities.OreIron {
+		t.Error("Explored ore cell should carry its tile")
+	}
+	if tile, ok := visited[[2]int{3, 20}]; !ok || tile != nil {
+		t.Error("Explored empty cell should be visited with a nil tile")
+	}
+}
@@ -9,33 +9,48 @@ import (
 
 const ChunkSize = 16 // 16x16 tiles per chunk
 
+const (
+	hillWavelength  = 32 // tiles between rolling hill control points
+	bumpWavelength  = 8  // tiles between small bump control points
+	cliffSegment    = 12 // tiles per cliff step segment
+	cliffChance     = 0.35
+	hillWeight      = 0.6
+	bumpWeight      = 0.2
+	cliffWeight     = 0.5
+	surfaceNoiseKey = -1 // chunkY salt keeping surface hashes apart from tile hashes
+)
+
 // ChunkGenerator handles procedural tile generation using Gaussian ore distribution
 type ChunkGenerator struct {
 	seed                int64
 	emptyRate, dirtRate float32
 	groundTileY         int
+	surfaceAmplitude    float32 // tiles the surface may rise above or sink below groundTileY
 }
 
-// NewChunkGenerator creates a generator with the given world seed and ground level
-func NewChunkGenerator(seed int64, groundLevel float32) *ChunkGenerator {
+// NewChunkGenerator creates a generator with the given world seed and configuration
+func NewChunkGenerator(seed int64, cfg Config) *ChunkGenerator {
 	return &ChunkGenerator{
-		seed:        seed,
-		emptyRate:   0.23, // 23% of underground tiles are empty
-		dirtRate:    0.67, // 67% of underground tiles are dirt
-		groundTileY: int(groundLevel / TileSize),
+		seed:             seed,
+		emptyRate:        0.23, // 23% of underground tiles are empty
+		dirtRate:         0.67, // 67% of underground tiles are dirt
+		groundTileY:      int(cfg.GroundLevel / TileSize),
+		surfaceAmplitude: cfg.SurfaceAmplitude,
 	}
 }
 
 // GenerateTile creates a single tile at the given tile coordinates
 // Returns a tile (Dirt, Ore, or Empty) based on Gaussian distribution
 func (cg *ChunkGenerator) GenerateTile(tileX, tileY int) *entities.Tile {
-	// Above ground: always empty (sky)
-	if tileY < cg.groundTileY {
+	surfaceTileY := cg.SurfaceTileY(tileX)
+
+	// Above the surface: always empty (sky)
+	if tileY < surfaceTileY {
 		return entities.NewTile(entities.TileTypeEmpty)
 	}
 
-	// Ground level is always solid dirt
-	if tileY == cg.groundTileY {
+	// The surface row is always solid dirt
+	if tileY == surfaceTileY {
 		return entities.NewTile(entities.TileTypeDirt)
 	}
 
@@ -74,6 +89,69 @@ func (cg *ChunkGenerator) GenerateTile(tileX, tileY int) *entities.Tile {
 	return entities.NewOreTile(*oreType)
 }
 
+// SurfaceTileY returns the grid row of the topmost solid tile in the given column
+// Rolling hills, small bumps and stepped cliffs are layered from seeded noise,
+// then scaled by surfaceAmplitude; an amplitude of 0 gives a flat ground row
+func (cg *ChunkGenerator) SurfaceTileY(tileX int) int {
+	if cg.surfaceAmplitude <= 0 {
+		return cg.groundTileY
+	}
+
+	offset := hillWeight*cg.smoothNoise(tileX, hillWavelength, 0) +
+		bumpWeight*cg.smoothNoise(tileX, bumpWavelength, 1) +
+		cliffWeight*cg.cliffNoise(tileX)
+
+	// Keep the combined shape within [-1, 1] before scaling
+	if offset > 1 {
+		offset = 1
+	} else if offset < -1 {
+		offset = -1
+	}
+
+	// Positive offsets raise the surface (smaller Y)
+	return cg.groundTileY - int(math.Round(float64(offset*cg.surfaceAmplitude)))
+}
+
+// smoothNoise is 1D value noise in [-1, 1]: random control points every wavelength
+// tiles, blended with smoothstep so hills and valleys have gentle slopes
+func (cg *ChunkGenerator) smoothNoise(tileX, wavelength, octave int) float32 {
+	cell := floorDiv(tileX, wavelength)
+	t := float32(tileX-cell*wavelength) / float32(wavelength)
+	t = t * t * (3 - 2*t)
+
+	a := cg.surfaceRandom(cell, octave)
+	b := cg.surfaceRandom(cell+1, octave)
+	return a + (b-a)*t
+}
+
+// cliffNoise is a step function in [-1, 1]: each segment either keeps the
+// base height or jumps by a random step, producing sheer cliff faces at edges
+func (cg *ChunkGenerator) cliffNoise(tileX int) float32 {
+	segment := floorDiv(tileX, cliffSegment)
+
+	const cliffOctave = 2
+	if (cg.surfaceRandom(segment, cliffOctave+1)+1)/2 >= cliffChance {
+		return 0
+	}
+	return cg.surfaceRandom(segment, cliffOctave)
+}
+
+// surfaceRandom returns a deterministic value in [-1, 1] for a noise control point
+func (cg *ChunkGenerator) surfaceRandom(cell, octave int) float32 {
+	hash := hashCoordinates(cg.seed, cell, surfaceNoiseKey, octave, 0)
+	unit := float64(uint64(hash)>>11) / float64(uint64(1)<<53) // [0, 1)
+	return float32(unit*2 - 1)
+}
+
+// floorDiv divides rounding toward negative infinity (Go's / truncates toward zero)
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
 // gaussianWeight calculates the weight of an ore at a given depth using Gaussian distribution
 // Formula: weight = maxWeight × e^(-(depth - peak)² / (2σ²))
 func (cg *ChunkGenerator) gaussianWeight(tileY float32, peak, sigma, maxWeight float32) float32 {
@@ -7,7 +7,7 @@ import (
 )
 
 func TestGaussianWeight_AtPeak(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 
 	// Test each ore at its peak depth
 	tests := []struct {
@@ -34,7 +34,7 @@ func TestGaussianWeight_AtPeak(t *testing.T) {
 }
 
 func TestGaussianWeight_Symmetry(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 	meta := entities.OreDistributions[entities.OreGold]
 
 	// Weight should be equal at equal distance from peak (230)
@@ -49,7 +49,7 @@ func TestGaussianWeight_Symmetry(t *testing.T) {
 }
 
 func TestGaussianWeight_FarFromPeak(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 	meta := entities.OreDistributions[entities.OreDiamond]
 
 	// Diamond peaks at 600, should have very low weight at 100 (500px away)
@@ -61,7 +61,7 @@ func TestGaussianWeight_FarFromPeak(t *testing.T) {
 }
 
 func TestCalculateOreWeights_MultipleOres(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 
 	// At depth 230 (gold's peak), multiple ores should have weights
 	weights := gen.calculateOreWeights(int(230 / 64)) // Convert pixels to tile coordinates
@@ -77,8 +77,8 @@ func TestCalculateOreWeights_MultipleOres(t *testing.T) {
 }
 
 func TestGenerateTile_Deterministic(t *testing.T) {
-	gen1 := NewChunkGenerator(12345, 640)
-	gen2 := NewChunkGenerator(12345, 640)
+	gen1 := NewChunkGenerator(12345, NewConfig(7680, 64000, 640))
+	gen2 := NewChunkGenerator(12345, NewConfig(7680, 64000, 640))
 
 	// Same seed + coords = same tile
 	for i := 0; i < 10; i++ {
@@ -97,7 +97,7 @@ func TestGenerateTile_Deterministic(t *testing.T) {
 }
 
 func TestGenerateTile_GroundLevel(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 	groundTileY := 10 // 640 / 64 = 10
 
 	// Test multiple X coordinates at ground level
@@ -111,7 +111,7 @@ func TestGenerateTile_GroundLevel(t *testing.T) {
 }
 
 func TestGenerateTile_EmptyRate(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 
 	emptyCount := 0
 	totalTiles := 1000
@@ -132,7 +132,7 @@ func TestGenerateTile_EmptyRate(t *testing.T) {
 }
 
 func TestGenerateTile_NoOreAtGroundLevel(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 	groundTileY := 10
 
 	// Ground level should never generate ore
@@ -145,6 +145,95 @@ func TestGenerateTile_NoOreAtGroundLevel(t *testing.T) {
 	}
 }
 
+func hillyTestConfig() Config {
+	cfg := NewConfig(7680, 64000, 640)
+	cfg.SurfaceAmplitude = 4
+	return cfg
+}
+
+func TestSurfaceTileY_FlatWithoutAmplitude(t *testing.T) {
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
+
+	for x := -50; x < 200; x++ {
+		if surface := gen.SurfaceTileY(x); surface != 10 {
+			t.Fatalf("Surface at X=%d should be the ground row 10, got %d", x, surface)
+		}
+	}
+}
+
+func TestSurfaceTileY_StaysWithinAmplitude(t *testing.T) {
+	gen := NewChunkGenerator(42, hillyTestConfig())
+
+	minSurface, maxSurface := 10, 10
+	for x := -200; x < 1000; x++ {
+		surface := gen.SurfaceTileY(x)
+		if surface < 6 || surface > 14 {
+			t.Fatalf("Surface at X=%d = %d, expected within 10±4", x, surface)
+		}
+		if surface < minSurface {
+			minSurface = surface
+		}
+		if surface > maxSurface {
+			maxSurface = surface
+		}
+	}
+
+	if minSurface == maxSurface {
+		t.Error("Hilly config should produce a surface that varies in height")
+	}
+}
+
+func TestSurfaceTileY_DeterministicPerSeed(t *testing.T) {
+	gen1 := NewChunkGenerator(42, hillyTestConfig())
+	gen2 := NewChunkGenerator(42, hillyTestConfig())
+	gen3 := NewChunkGenerator(43, hillyTestConfig())
+
+	differs := false
+	for x := 0; x < 500; x++ {
+		if gen1.SurfaceTileY(x) != gen2.SurfaceTileY(x) {
+			t.Fatalf("Same seed should give same surface at X=%d", x)
+		}
+		if gen1.SurfaceTileY(x) != gen3.SurfaceTileY(x) {
+			differs = true
+		}
+	}
+
+	if !differs {
+		t.Error("Different seeds should give different surfaces")
+	}
+}
+
+func TestGenerateTile_FollowsSurface(t *testing.T) {
+	gen := NewChunkGenerator(42, hillyTestConfig())
+
+	for x := 0; x < 200; x++ {
+		surface := gen.SurfaceTileY(x)
+
+		if tile := gen.GenerateTile(x, surface-1); tile.Type != entities.TileTypeEmpty {
+			t.Errorf("Tile above surface at X=%d should be empty, got %v", x, tile.Type)
+		}
+		if tile := gen.GenerateTile(x, surface); tile.Type != entities.TileTypeDirt {
+			t.Errorf("Surface tile at X=%d should be dirt, got %v", x, tile.Type)
+		}
+	}
+}
+
+func TestFloorDiv(t *testing.T) {
+	tests := []struct{ a, b, want int }{
+		{7, 4, 1},
+		{8, 4, 2},
+		{-1, 4, -1},
+		{-4, 4, -1},
+		{-5, 4, -2},
+	}
+
+	for _, tt := range tests {
+		if got := floorDiv(tt.a, tt.b); got != tt.want {
+			t.Errorf("floorDiv(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
 func TestHashCoordinates_Deterministic(t *testing.T) {
 	seed := int64(12345)
 
@@ -171,7 +260,7 @@ func TestHashCoordinates_Unique(t *testing.T) {
 }
 
 func TestSelectOreByWeight_Distribution(t *testing.T) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 
 	// Create simple weight distribution
 	weights := map[entities.OreType]float32{
@@ -237,7 +237,7 @@ func TestIntegration_DifferentSeeds(t *testing.T) {
 
 // Benchmark chunk generation performance
 func BenchmarkChunkGeneration(b *testing.B) {
-	gen := NewChunkGenerator(42, 640)
+	gen := NewChunkGenerator(42, NewConfig(7680, 64000, 640))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
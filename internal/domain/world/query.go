@@ -0,0 +1,52 @@
+package world
+
+import "github.com/Kishlin/drill-game/internal/domain/entities"
+
+// TileVisitor is called once per stored (non-empty) tile found by a query
+type TileVisitor func(gridX, gridY int, tile *entities.Tile)
+
+// ForEachTileInRect visits every non-empty tile in the inclusive grid rectangle
+// Chunks overlapping the rectangle are loaded once up front, so the cost scales
+// with the rectangle's area rather than with the number of explored tiles
+// Tiles are visited column by column (X outer, Y inner) for deterministic ordering
+// Visitors may remove the tile they are given (e.g. DrillTileAtGrid)
+func (w *World) ForEachTileInRect(minX, maxX, minY, maxY int, visit TileVisitor) {
+	// Negative grid coordinates never hold tiles
+	if minX < 0 {
+		minX = 0
+	}
+	if minY < 0 {
+		minY = 0
+	}
+	if minX > maxX || minY > maxY {
+		return
+	}
+
+	for chunkX := minX / ChunkSize; chunkX <= maxX/ChunkSize; chunkX++ {
+		for chunkY := minY / ChunkSize; chunkY <= maxY/ChunkSize; chunkY++ {
+			w.EnsureChunkLoaded(chunkX, chunkY)
+		}
+	}
+
+	for x := minX; x <= maxX; x++ {
+		for y := minY; y <= maxY; y++ {
+			if tile := w.tiles[[2]int{x, y}]; tile != nil {
+				visit(x, y, tile)
+			}
+		}
+	}
+}
+
+// ForEachTileInRadius visits every non-empty tile within a circular grid radius
+// A tile is included when dx² + dy² <= radius², matching the bomb blast shape
+func (w *World) ForEachTileInRadius(centerX, centerY, radius int, visit TileVisitor) {
+	radiusSq := radius * radius
+
+	w.ForEachTileInRect(centerX-radius, centerX+radius, centerY-radius, centerY+radius,
+		func(gridX, gridY int, tile *entities.Tile) {
+			dx, dy := gridX-centerX, gridY-centerY
+			if dx*dx+dy*dy <= radiusSq {
+				visit(gridX, gridY, tile)
+			}
+		})
+}
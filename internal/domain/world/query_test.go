@@ -0,0 +1,96 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/Kishlin/drill-game/internal/domain/entities"
+)
+
+func TestForEachTileInRect_VisitsOnlyTilesInside(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	// Sky chunk: generated empty, so only our tiles are stored
+	w.EnsureChunkLoaded(0, 0)
+	w.SetTile(2, 2, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(4, 3, entities.NewOreTile(entities.OreGold))
+	w.SetTile(8, 8, entities.NewTile(entities.TileTypeDirt)) // Outside rect
+
+	visited := map[[2]int]bool{}
+	w.ForEachTileInRect(1, 5, 1, 5, func(gridX, gridY int, tile *entities.Tile) {
+		visited[[2]int{gridX, gridY}] = true
+	})
+
+	if len(visited) != 2 || !visited[[2]int{2, 2}] || !visited[[2]int{4, 3}] {
+		t.Errorf("Expected exactly tiles (2,2) and (4,3), got %v", visited)
+	}
+}
+
+func TestForEachTileInRect_LoadsOverlappingChunks(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	// Rect spans chunks (0,1) and (1,1), both underground
+	w.ForEachTileInRect(ChunkSize-1, ChunkSize, ChunkSize, ChunkSize, func(int, int, *entities.Tile) {})
+
+	if !w.loadedChunks[[2]int{0, 1}] || !w.loadedChunks[[2]int{1, 1}] {
+		t.Error("Both chunks overlapping the rect should be loaded")
+	}
+	if w.loadedChunks[[2]int{2, 1}] {
+		t.Error("Chunks outside the rect should not be loaded")
+	}
+}
+
+func TestForEachTileInRect_IgnoresNegativeCoordinates(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+
+	count := 0
+	w.ForEachTileInRect(-10, -1, -10, -1, func(int, int, *entities.Tile) {
+		count++
+	})
+
+	if count != 0 {
+		t.Errorf("Expected no tiles at negative coordinates, got %d", count)
+	}
+}
+
+func TestForEachTileInRadius_CircularShape(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+	w.EnsureChunkLoaded(0, 0)
+
+	// Fill a 5×5 block centred on (5,5)
+	for x := 3; x <= 7; x++ {
+		for y := 3; y <= 7; y++ {
+			w.SetTile(x, y, entities.NewTile(entities.TileTypeDirt))
+		}
+	}
+
+	visited := map[[2]int]bool{}
+	w.ForEachTileInRadius(5, 5, 2, func(gridX, gridY int, tile *entities.Tile) {
+		visited[[2]int{gridX, gridY}] = true
+	})
+
+	// Radius 2 circle covers 13 cells: corners like (3,3) are excluded
+	if len(visited) != 13 {
+		t.Errorf("Expected 13 tiles in radius 2, got %d", len(visited))
+	}
+	if visited[[2]int{3, 3}] {
+		t.Error("Corner (3,3) should be outside the circle")
+	}
+	if !visited[[2]int{5, 3}] || !visited[[2]int{7, 5}] {
+		t.Error("Axis-aligned edge tiles should be inside the circle")
+	}
+}
+
+func TestForEachTileInRadius_VisitorCanRemoveTiles(t *testing.T) {
+	w := NewWorld(7680, 64000, 640, 42)
+	w.EnsureChunkLoaded(0, 0)
+	w.SetTile(5, 5, entities.NewTile(entities.TileTypeDirt))
+	w.SetTile(5, 6, entities.NewTile(entities.TileTypeDirt))
+
+	w.ForEachTileInRadius(5, 5, 1, func(gridX, gridY int, _ *entities.Tile) {
+		w.DrillTileAtGrid(gridX, gridY)
+	})
+
+	if w.GetTileAtGrid(5, 5) != nil || w.GetTileAtGrid(5, 6) != nil {
+		t.Error("Tiles removed during iteration should be gone")
+	}
+}
@@ -5,24 +5,29 @@ import "github.com/Kishlin/drill-game/internal/domain/entities"
 const TileSize = 64 // pixels
 
 type World struct {
-	GroundLevel float32
-	Width       float32
-	Height      float32
-	tiles       map[[2]int]*entities.Tile // Sparse map: [x, y] -> Tile
+	Config                           // Dimensions and depth curves (Width, Height, GroundLevel, ...)
+	tiles  map[[2]int]*entities.Tile // Sparse map: [x, y] -> Tile
 
 	generator    *ChunkGenerator
 	loadedChunks map[[2]int]bool
+	explored     map[[2]int]ExploredMask // Fog of war: seen cells per chunk
+	damage       map[[2]int]float32      // Hit points worn off partially drilled tiles
 	seed         int64
 }
 
 func NewWorld(width, height, groundLevel float32, seed int64) *World {
+	return NewWorldFromConfig(NewConfig(width, height, groundLevel), seed)
+}
+
+// NewWorldFromConfig creates a world whose depth-derived values all follow cfg
+func NewWorldFromConfig(cfg Config, seed int64) *World {
 	return &World{
-		Width:        width,
-		Height:       height,
-		GroundLevel:  groundLevel,
+		Config:       cfg,
 		tiles:        make(map[[2]int]*entities.Tile),
-		generator:    NewChunkGenerator(seed, groundLevel),
+		generator:    NewChunkGenerator(seed, cfg),
 		loadedChunks: make(map[[2]int]bool),
+		explored:     make(map[[2]int]ExploredMask),
+		damage:       make(map[[2]int]float32),
 		seed:         seed,
 	}
 }
@@ -79,6 +84,37 @@ func (w *World) GetGroundLevel() float32 {
 	return w.GroundLevel
 }
 
+// SurfaceTileY returns the grid row of the natural surface in a column
+// This is the generated terrain height; drilling the surface tile does not change it
+func (w *World) SurfaceTileY(gridX int) int {
+	return w.generator.SurfaceTileY(gridX)
+}
+
+// SurfaceY returns the pixel Y of the top of the surface in the column containing pixelX
+func (w *World) SurfaceY(pixelX float32) float32 {
+	return float32(w.SurfaceTileY(int(pixelX/TileSize))) * TileSize
+}
+
+// SurfaceYUnder returns the highest surface (smallest Y) beneath a horizontal span
+// Used to rest buildings and spawn points on terrain without overlapping hills
+func (w *World) SurfaceYUnder(x, width float32) float32 {
+	minGridX := int(x / TileSize)
+	maxGridX := int((x + width - 0.001) / TileSize)
+
+	highest := w.SurfaceY(x)
+	for gridX := minGridX; gridX <= maxGridX; gridX++ {
+		if surfaceY := float32(w.SurfaceTileY(gridX)) * TileSize; surfaceY < highest {
+			highest = surfaceY
+		}
+	}
+	return highest
+}
+
+// GetConfig returns the world configuration (dimensions and depth curves)
+func (w *World) GetConfig() Config {
+	return w.Config
+}
+
 // GetTileAt returns tile at pixel coordinates (returns nil if empty/air)
 func (w *World) GetTileAt(pixelX, pixelY float32) *entities.Tile {
 	tileX := int(pixelX / TileSize)
@@ -101,12 +137,7 @@ func (w *World) DrillTile(pixelX, pixelY float32) (*entities.Tile, bool) {
 	tileX := int(pixelX / TileSize)
 	tileY := int(pixelY / TileSize)
 
-	tile := w.tiles[[2]int{tileX, tileY}]
-	if tile != nil && tile.IsDrillable() {
-		delete(w.tiles, [2]int{tileX, tileY})
-		return tile, true
-	}
-	return nil, false
+	return w.DrillTileAtGrid(tileX, tileY)
 }
 
 // DrillTileAtGrid removes tile at grid coordinates
@@ -115,6 +146,7 @@ func (w *World) DrillTileAtGrid(gridX, gridY int) (*entities.Tile, bool) {
 	tile := w.tiles[[2]int{gridX, gridY}]
 	if tile != nil && tile.IsDrillable() {
 		delete(w.tiles, [2]int{gridX, gridY})
+		delete(w.damage, [2]int{gridX, gridY})
 		return tile, true
 	}
 	return nil, false
@@ -126,13 +158,9 @@ func (w *World) IsTileSolid(pixelX, pixelY float32) bool {
 	return tile != nil && tile.IsSolid()
 }
 
-// GetAllTiles returns all tiles (for rendering)
-func (w *World) GetAllTiles() map[[2]int]*entities.Tile {
-	return w.tiles
-}
-
 // SetTile sets a tile at the given grid coordinates (for testing)
 func (w *World) SetTile(gridX, gridY int, tile *entities.Tile) {
+	delete(w.damage, [2]int{gridX, gridY})
 	if tile == nil || tile.Type == entities.TileTypeEmpty {
 		delete(w.tiles, [2]int{gridX, gridY})
 	} else {
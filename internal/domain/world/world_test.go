@@ -166,3 +166,25 @@ func TestDrillTile_RemovesFromSparseMap(t *testing.T) {
 		t.Error("Drilled tile should be nil (removed from sparse map)")
 	}
 }
+
+func TestSurfaceYUnder_ReturnsHighestColumn(t *testing.T) {
+	cfg := NewConfig(7680, 64000, 640)
+	cfg.SurfaceAmplitude = 4
+	world := NewWorldFromConfig(cfg, 42)
+
+	for gridX := 0; gridX < 100; gridX++ {
+		x := float32(gridX * TileSize)
+		got := world.SurfaceYUnder(x, 5*TileSize)
+
+		highest := world.SurfaceY(x)
+		for i := 0; i < 5; i++ {
+			if y := float32(world.SurfaceTileY(gridX+i) * TileSize); y < highest {
+				highest = y
+			}
+		}
+
+		if got != highest {
+			t.Fatalf("SurfaceYUnder at grid X=%d = %f, expected highest surface %f", gridX, got, highest)
+		}
+	}
+}